@@ -0,0 +1,20 @@
+# Parse a PostgreSQL key=value connection string
+data "netparse_dsn" "example" {
+  dsn = "host=db.example.com port=6432 dbname=orders user=app password='s3cr3t' sslmode=require"
+}
+
+output "dsn" {
+  value     = data.netparse_dsn.example
+  sensitive = true
+
+  # {
+  #   database = "orders"
+  #   driver   = "postgres"
+  #   dsn      = "host=db.example.com port=6432 dbname=orders user=app password='s3cr3t' sslmode=require"
+  #   hosts    = ["db.example.com"]
+  #   params   = { sslmode = "require" }
+  #   password = "s3cr3t"
+  #   ports    = [6432]
+  #   user     = "app"
+  # }
+}
@@ -0,0 +1,18 @@
+# Get the query from the URL
+data "netparse_url" "example" {
+  url = "https://example.com/search?tag=a%26b&tag=c+d&page=2"
+}
+
+# Then decode the query parameters
+data "netparse_url_query" "example" {
+  query = data.netparse_url.example.query
+}
+
+output "params" {
+  value = data.netparse_url_query.example.params
+
+  # {
+  #   page = ["2"]
+  #   tag  = ["a&b", "c d"]
+  # }
+}
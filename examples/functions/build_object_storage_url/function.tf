@@ -0,0 +1,32 @@
+# Convert a virtual-hosted S3 URL to an s3:// URI
+output "s3_uri" {
+  value = provider::netparse::build_object_storage_url(merge(
+    provider::netparse::parse_object_storage_url("https://my-bucket.s3.eu-west-1.amazonaws.com/reports/2024.csv"),
+    { style = "uri" },
+  ))
+
+  # "s3://my-bucket/reports/2024.csv"
+}
+
+output "s3_path_style" {
+  value = provider::netparse::build_object_storage_url({
+    provider = "s3"
+    style    = "path"
+    bucket   = "my-bucket"
+    key      = "reports/2024.csv"
+    region   = "us-west-2"
+  })
+
+  # "https://s3.us-west-2.amazonaws.com/my-bucket/reports/2024.csv"
+}
+
+output "gcs_virtual_hosted" {
+  value = provider::netparse::build_object_storage_url({
+    provider = "gcs"
+    style    = "virtual-hosted"
+    bucket   = "my-bucket"
+    key      = "data/file.json"
+  })
+
+  # "https://my-bucket.storage.googleapis.com/data/file.json"
+}
@@ -0,0 +1,24 @@
+locals {
+  template = "https://api.example.com/repos/{owner}/{repo}/issues{?state,labels}"
+}
+
+output "issues" {
+  value = provider::netparse::expand_uri_template(local.template, {
+    owner  = "hashicorp"
+    repo   = "terraform"
+    state  = "open"
+    labels = ["bug", "needs triage"]
+  })
+
+  # "https://api.example.com/repos/hashicorp/terraform/issues?state=open&labels=bug,needs%20triage"
+}
+
+output "webhook" {
+  value = provider::netparse::expand_uri_template("{+base}/hooks{/id}{?params*}", {
+    base   = "https://hooks.example.com/v1"
+    id     = 42
+    params = { event = "push", secret = "a&b" }
+  })
+
+  # "https://hooks.example.com/v1/hooks/42?event=push&secret=a%26b"
+}
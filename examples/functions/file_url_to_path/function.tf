@@ -0,0 +1,6 @@
+locals {
+  posix   = provider::netparse::file_url_to_path("file:///var/lib/app%20data/state.json")                     # "/var/lib/app data/state.json"
+  drive   = provider::netparse::file_url_to_path("file:///C:/Users/me/My%20Documents", { windows = true })    # "C:\\Users\\me\\My Documents"
+  unc     = provider::netparse::file_url_to_path("file://server/share/artifacts/app.zip", { windows = true }) # "\\\\server\\share\\artifacts\\app.zip"
+  host    = provider::netparse::file_url_to_path("file://localhost/etc/hosts?version=2#top")                  # "/etc/hosts"
+}
@@ -0,0 +1,5 @@
+locals {
+  example1 = provider::netparse::iri_to_uri("https://bücher.example/straße?q=日本") # "https://xn--bcher-kva.example/stra%C3%9Fe?q=%E6%97%A5%E6%9C%AC"
+  example2 = provider::netparse::iri_to_uri("https://example.com/café#menü")      # "https://example.com/caf%C3%A9#men%C3%BC"
+  example3 = provider::netparse::iri_to_uri("https://example.com/a%20b")          # "https://example.com/a%20b"
+}
@@ -0,0 +1,8 @@
+locals {
+  example1 = provider::netparse::normalize_url("HTTPS://Example.COM:443/a/./b/../c/%7euser") # "https://example.com/a/c/~user"
+
+  example2 = provider::netparse::normalize_url("https://example.com/callback?state=1&code=2#", {
+    sort_query            = true
+    remove_empty_fragment = true
+  }) # "https://example.com/callback?code=2&state=1"
+}
@@ -0,0 +1,33 @@
+locals {
+  # A PEM certificate embedded as a base64 data URI
+  ca_uri = "data:application/x-pem-file;base64,LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUIuLi4KLS0tLS1FTkQgQ0VSVElGSUNBVEUtLS0tLQo="
+}
+
+output "ca" {
+  value = provider::netparse::parse_data_uri(local.ca_uri)
+
+  # {
+  #   base64      = true
+  #   data        = "-----BEGIN CERTIFICATE-----\nMIIB...\n-----END CERTIFICATE-----\n"
+  #   data_base64 = "LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUIuLi4KLS0tLS1FTkQgQ0VSVElGSUNBVEUtLS0tLQo="
+  #   media_type  = "application/x-pem-file"
+  #   params      = {}
+  # }
+}
+
+output "text" {
+  value = provider::netparse::parse_data_uri("data:text/plain;charset=utf-8,Hello%2C%20World%21")
+
+  # {
+  #   base64      = false
+  #   data        = "Hello, World!"
+  #   data_base64 = "SGVsbG8sIFdvcmxkIQ=="
+  #   media_type  = "text/plain"
+  #   params      = { charset = "utf-8" }
+  # }
+}
+
+# Binary payloads, like images, are only returned base64 encoded
+output "icon" {
+  value = provider::netparse::parse_data_uri("data:image/png;base64,iVBORw0KGgo=").data_base64 # "iVBORw0KGgo="
+}
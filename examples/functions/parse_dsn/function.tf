@@ -0,0 +1,34 @@
+# Parse a go-sql-driver/mysql DSN
+output "mysql" {
+  value = provider::netparse::parse_dsn("app:s3cr3t@tcp(db.example.com:3306)/orders?parseTime=true")
+
+  # {
+  #   database = "orders"
+  #   driver   = "mysql"
+  #   hosts    = ["db.example.com"]
+  #   params   = { parseTime = "true" }
+  #   password = "s3cr3t"
+  #   ports    = [3306]
+  #   user     = "app"
+  # }
+}
+
+# Parse a SQL Server connection string
+output "sqlserver" {
+  value = provider::netparse::parse_dsn("Server=tcp:sql.example.com,1433;Initial Catalog=orders;User ID=sa;Password=s3cr3t;Encrypt=True")
+
+  # {
+  #   database = "orders"
+  #   driver   = "sqlserver"
+  #   hosts    = ["sql.example.com"]
+  #   params   = { Encrypt = "True" }
+  #   password = "s3cr3t"
+  #   ports    = [1433]
+  #   user     = "sa"
+  # }
+}
+
+# Get the hosts of a JDBC URL
+output "jdbc_hosts" {
+  value = provider::netparse::parse_dsn("jdbc:postgresql://db1.example.com,db2.example.com:5433/orders?user=app").hosts # ["db1.example.com", "db2.example.com"]
+}
@@ -0,0 +1,23 @@
+locals {
+  remote = "git@github.com:gmeligio/terraform-provider-netparse.git"
+}
+
+output "remote" {
+  value = provider::netparse::parse_git_url(local.remote)
+
+  # {
+  #   host      = "github.com"
+  #   https_url = "https://github.com/gmeligio/terraform-provider-netparse.git"
+  #   owner     = "gmeligio"
+  #   port      = null
+  #   repo      = "terraform-provider-netparse"
+  #   ssh_url   = "git@github.com:gmeligio/terraform-provider-netparse.git"
+  #   transport = "ssh"
+  #   user      = "git"
+  # }
+}
+
+# Convert an HTTPS remote to its SSH form
+output "ssh_url" {
+  value = provider::netparse::parse_git_url("https://gitlab.com/group/subgroup/project").ssh_url # "git@gitlab.com:group/subgroup/project.git"
+}
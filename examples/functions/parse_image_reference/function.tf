@@ -0,0 +1,18 @@
+output "private_image" {
+  value = provider::netparse::parse_image_reference("registry.example.com:5000/team/app:1.2@sha256:3e2a3e5e0f1c0d2a5b6c7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9012345")
+
+  # {
+  #   digest_algorithm = "sha256"
+  #   digest_hex       = "3e2a3e5e0f1c0d2a5b6c7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9012345"
+  #   name             = "registry.example.com:5000/team/app"
+  #   port             = 5000
+  #   registry         = "registry.example.com"
+  #   repository       = "team/app"
+  #   tag              = "1.2"
+  # }
+}
+
+# Official images get the docker.io registry and the library namespace
+output "official_image" {
+  value = provider::netparse::parse_image_reference("nginx:1.27").name # "docker.io/library/nginx"
+}
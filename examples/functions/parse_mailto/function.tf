@@ -0,0 +1,20 @@
+locals {
+  alert_contact = "mailto:ops@example.com,oncall@example.com?cc=lead@example.com&subject=Disk%20usage%20alert&body=Usage%20is%20above%2090%25"
+}
+
+output "alert_contact" {
+  value = provider::netparse::parse_mailto(local.alert_contact)
+
+  # {
+  #   bcc     = []
+  #   body    = "Usage is above 90%"
+  #   cc      = ["lead@example.com"]
+  #   headers = {}
+  #   subject = "Disk usage alert"
+  #   to      = ["ops@example.com", "oncall@example.com"]
+  # }
+}
+
+output "recipients" {
+  value = provider::netparse::parse_mailto(local.alert_contact).to # ["ops@example.com", "oncall@example.com"]
+}
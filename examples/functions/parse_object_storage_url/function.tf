@@ -0,0 +1,31 @@
+output "s3" {
+  value = provider::netparse::parse_object_storage_url("https://my-bucket.s3.eu-west-1.amazonaws.com/reports/2024.csv")
+
+  # {
+  #   account  = ""
+  #   bucket   = "my-bucket"
+  #   endpoint = "s3.eu-west-1.amazonaws.com"
+  #   key      = "reports/2024.csv"
+  #   provider = "s3"
+  #   region   = "eu-west-1"
+  #   style    = "virtual-hosted"
+  # }
+}
+
+output "azure" {
+  value = provider::netparse::parse_object_storage_url("https://myaccount.blob.core.windows.net/mycontainer/dir/blob.txt")
+
+  # {
+  #   account  = "myaccount"
+  #   bucket   = "mycontainer"
+  #   endpoint = "blob.core.windows.net"
+  #   key      = "dir/blob.txt"
+  #   provider = "azure"
+  #   region   = ""
+  #   style    = "path"
+  # }
+}
+
+output "gcs_bucket" {
+  value = provider::netparse::parse_object_storage_url("gs://my-bucket/data/file.json").bucket # "my-bucket"
+}
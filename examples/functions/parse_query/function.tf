@@ -0,0 +1,14 @@
+locals {
+  # Get the query from the URL
+  url = provider::netparse::parse_url("https://example.com/search?tag=a%26b&tag=c+d&page=2")
+}
+
+# Then decode the query parameters
+output "params" {
+  value = provider::netparse::parse_query(local.url.query)
+
+  # {
+  #   page = ["2"]
+  #   tag  = ["a&b", "c d"]
+  # }
+}
@@ -0,0 +1,21 @@
+locals {
+  resource_tag = "URN:Acme:billing/invoice%2f42?+version=3#line-7"
+}
+
+output "resource_tag" {
+  value = provider::netparse::parse_urn(local.resource_tag)
+
+  # {
+  #   f_component = "line-7"
+  #   nid         = "acme"
+  #   normalized  = "urn:acme:billing/invoice%2F42"
+  #   nss         = "billing/invoice%2f42"
+  #   q_component = ""
+  #   r_component = "version=3"
+  # }
+}
+
+# Compare URNs following the equivalence rules of RFC 8141
+output "equivalent" {
+  value = provider::netparse::parse_urn(local.resource_tag).normalized == provider::netparse::parse_urn("urn:acme:billing/invoice%2F42").normalized # true
+}
@@ -0,0 +1,5 @@
+locals {
+  posix = provider::netparse::path_to_file_url("/var/lib/app data/state#1.json")                            # "file:///var/lib/app%20data/state%231.json"
+  drive = provider::netparse::path_to_file_url("C:\\Users\\me\\My Documents", { windows = true })           # "file:///C:/Users/me/My%20Documents"
+  unc   = provider::netparse::path_to_file_url("\\\\server\\share\\artifacts\\app.zip", { windows = true }) # "file://server/share/artifacts/app.zip"
+}
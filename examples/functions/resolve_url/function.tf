@@ -0,0 +1,8 @@
+locals {
+  base = "https://api.example.com/v1/users/"
+
+  example1 = provider::netparse::resolve_url(local.base, "../health")             # "https://api.example.com/v1/health"
+  example2 = provider::netparse::resolve_url(local.base, "/static/app.js")        # "https://api.example.com/static/app.js"
+  example3 = provider::netparse::resolve_url(local.base, "//cdn.example.com/a.js") # "https://cdn.example.com/a.js"
+  example4 = provider::netparse::resolve_url(local.base, "?page=2")               # "https://api.example.com/v1/users/?page=2"
+}
@@ -0,0 +1,13 @@
+locals {
+  app_url = "https://app.example.com/dashboard"
+  api_url = "https://api.example.com/v1"
+}
+
+# Check whether the browser needs CORS to call the API
+output "needs_cors" {
+  value = !provider::netparse::same_origin(local.app_url, local.api_url) # true
+}
+
+output "default_port" {
+  value = provider::netparse::same_origin("https://example.com", "HTTPS://EXAMPLE.com:443/login") # true
+}
@@ -0,0 +1,19 @@
+locals {
+  app_url  = "https://app.example.co.uk"
+  auth_url = "https://login.example.co.uk/authorize"
+}
+
+# Cookies with SameSite=Lax or Strict are sent between same-site URLs
+output "cookie_same_site" {
+  value = provider::netparse::same_site(local.app_url, local.auth_url) ? "Lax" : "None" # "Lax"
+}
+
+# Subdomains of a public suffix, like github.io, are different sites
+output "github_pages" {
+  value = provider::netparse::same_site("https://alice.github.io", "https://bob.github.io") # false
+}
+
+# Ignore the scheme, like browsers did before schemeful same-site
+output "schemeless" {
+  value = provider::netparse::same_site("https://www.example.com", "http://api.example.com", { schemeful = false }) # true
+}
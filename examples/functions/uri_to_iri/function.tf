@@ -0,0 +1,5 @@
+locals {
+  example1 = provider::netparse::uri_to_iri("https://xn--bcher-kva.example/stra%C3%9Fe?q=%E6%97%A5%E6%9C%AC") # "https://bücher.example/straße?q=日本"
+  example2 = provider::netparse::uri_to_iri("https://example.com/a%20b%2Fc")                                # "https://example.com/a%20b%2Fc"
+  example3 = provider::netparse::uri_to_iri("https://example.com/%C3%A9%FF")                                # "https://example.com/é%FF"
+}
@@ -0,0 +1,10 @@
+locals {
+  username = provider::netparse::url_escape("ci-bot@example.com", "userinfo")     # "ci-bot%40example.com"
+  segment  = provider::netparse::url_escape("feature/login page", "path-segment") # "feature%2Flogin%20page"
+  path     = provider::netparse::url_escape("/docs/release notes", "path")        # "/docs/release%20notes"
+  key      = provider::netparse::url_escape("filter[a=b]", "query-key")           # "filter[a%3Db]"
+  value    = provider::netparse::url_escape("a=1&b=2 3", "query-value")           # "a=1%26b=2%203"
+
+  # Build a URL from escaped components
+  url = "https://${local.username}:${provider::netparse::url_escape("p@ss:word", "userinfo")}@git.example.com/repos/${local.segment}?q=${local.value}"
+}
@@ -0,0 +1,29 @@
+locals {
+  api_pattern = "https://*.example.com/api/:version/*"
+}
+
+output "api_route" {
+  value = provider::netparse::url_pattern_match(local.api_pattern, "https://eu.example.com/api/v2/orders/42")
+
+  # {
+  #   groups = {
+  #     hash     = { "0" = "" }
+  #     hostname = { "0" = "eu" }
+  #     password = { "0" = "" }
+  #     pathname = { "0" = "orders/42", version = "v2" }
+  #     port     = {}
+  #     protocol = {}
+  #     search   = { "0" = "" }
+  #     username = { "0" = "" }
+  #   }
+  #   matches = true
+  # }
+}
+
+output "order_id" {
+  value = provider::netparse::url_pattern_match({ pathname = "/orders/:id(\\d+)" }, "https://shop.example.com/orders/42").groups.pathname.id # "42"
+}
+
+output "is_docs_page" {
+  value = provider::netparse::url_pattern_match("https://example.com/Docs/*", "https://example.com/docs/intro", { ignore_case = true }).matches # true
+}
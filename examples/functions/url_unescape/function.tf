@@ -0,0 +1,6 @@
+locals {
+  username = provider::netparse::url_unescape("ci-bot%40example.com", "userinfo")       # "ci-bot@example.com"
+  segment  = provider::netparse::url_unescape("feature%2Flogin%20page", "path-segment") # "feature/login page"
+  value    = provider::netparse::url_unescape("release+notes%2B2", "query-value")       # "release notes+2"
+  path     = provider::netparse::url_unescape("/c++/a%20b", "path")                     # "/c++/a b"
+}
@@ -0,0 +1,40 @@
+variable "api_url" {
+  type    = string
+  default = "https://api.example.com/v1"
+
+  validation {
+    condition = provider::netparse::validate_url(var.api_url, {
+      require_tls           = true
+      forbid_credentials    = true
+      allowed_host_suffixes = ["example.com"]
+    }).valid
+
+    error_message = "The API URL must use TLS, have no credentials and be within example.com."
+  }
+}
+
+output "violations" {
+  value = provider::netparse::validate_url("http://admin@10.0.0.1:8080", {
+    require_tls     = true
+    forbid_ip_hosts = true
+    max_port        = 1024
+  })
+
+  # {
+  #   valid = false
+  #   violations = [
+  #     {
+  #       message = "scheme \"http\" is not secured with TLS"
+  #       rule    = "require_tls"
+  #     },
+  #     {
+  #       message = "host \"10.0.0.1\" is an IP address"
+  #       rule    = "forbid_ip_hosts"
+  #     },
+  #     {
+  #       message = "port 8080 is greater than 1024"
+  #       rule    = "port_range"
+  #     },
+  #   ]
+  # }
+}
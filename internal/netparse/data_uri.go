@@ -0,0 +1,190 @@
+package netparse
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// defaultDataURIMediaType is the media type of a data URI that doesn't set
+// one, or sets one that is not valid.
+const defaultDataURIMediaType = "text/plain"
+
+// DataURIModel describes a data URI.
+type DataURIModel struct {
+	// MediaType is the lowercase type and subtype of the payload, like
+	// text/plain.
+	MediaType string
+	// Params are the parameters of the media type, like charset. Names are
+	// lowercase.
+	Params map[string]string
+	// Base64 reports whether the payload is base64 encoded in the URI.
+	Base64 bool
+	// Data is the decoded payload.
+	Data []byte
+}
+
+// ParseDataURI parses a data URI and decodes its payload. A fragment is
+// ignored.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc2397
+// https://fetch.spec.whatwg.org/#data-url-processor
+func ParseDataURI(u string) (*DataURIModel, error) {
+	input := strings.Trim(u, asciiWhitespace)
+	if len(input) < len("data:") || !strings.EqualFold(input[:len("data:")], "data:") {
+		return nil, fmt.Errorf("URI %q doesn't have the data scheme", u)
+	}
+	input, _, _ = strings.Cut(input[len("data:"):], "#")
+
+	mediaType, body, ok := strings.Cut(input, ",")
+	if !ok {
+		return nil, fmt.Errorf("data URI %q is missing the comma that starts the payload", u)
+	}
+	mediaType = strings.Trim(mediaType, asciiWhitespace)
+
+	model := &DataURIModel{
+		Data: []byte(percentDecode(body)),
+	}
+
+	if i := strings.LastIndexByte(mediaType, ';'); i >= 0 && strings.EqualFold(strings.TrimRight(mediaType[i+1:], asciiWhitespace), "base64") {
+		data, err := forgivingBase64Decode(string(model.Data))
+		if err != nil {
+			return nil, err
+		}
+
+		model.Base64 = true
+		model.Data = data
+		mediaType = mediaType[:i]
+	}
+
+	if strings.HasPrefix(mediaType, ";") {
+		mediaType = defaultDataURIMediaType + mediaType
+	}
+
+	essence, params, ok := parseMediaType(mediaType)
+	if !ok {
+		essence, params = defaultDataURIMediaType, map[string]string{"charset": "US-ASCII"}
+	}
+	model.MediaType = essence
+	model.Params = params
+
+	return model, nil
+}
+
+const asciiWhitespace = "\t\n\f\r "
+
+// parseMediaType parses a MIME type into its lowercase essence and its
+// parameters. It reports false when the type or subtype is not valid.
+// https://mimesniff.spec.whatwg.org/#parse-a-mime-type
+func parseMediaType(s string) (string, map[string]string, bool) {
+	s = strings.Trim(s, asciiWhitespace)
+
+	typ, rest, ok := strings.Cut(s, "/")
+	if !ok || !isHTTPToken(typ) {
+		return "", nil, false
+	}
+
+	subtype, rest, _ := strings.Cut(rest, ";")
+	subtype = strings.TrimRight(subtype, asciiWhitespace)
+	if !isHTTPToken(subtype) {
+		return "", nil, false
+	}
+
+	params := map[string]string{}
+	for rest != "" {
+		rest = strings.TrimLeft(rest, asciiWhitespace)
+
+		var name string
+		i := strings.IndexAny(rest, ";=")
+		if i < 0 {
+			break
+		}
+		name, rest = strings.ToLower(rest[:i]), rest[i:]
+		if rest[0] == ';' {
+			rest = rest[1:]
+			continue
+		}
+		rest = rest[1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			value, rest = readQuotedString(rest)
+			_, rest, _ = strings.Cut(rest, ";")
+		} else {
+			value, rest, _ = strings.Cut(rest, ";")
+			value = strings.TrimRight(value, asciiWhitespace)
+			if value == "" {
+				continue
+			}
+		}
+
+		if _, exists := params[name]; !exists && name != "" && isHTTPToken(name) {
+			params[name] = value
+		}
+	}
+
+	return strings.ToLower(typ) + "/" + strings.ToLower(subtype), params, true
+}
+
+// readQuotedString reads an HTTP quoted string that starts at s[0], and
+// returns its value and the rest of s.
+// https://fetch.spec.whatwg.org/#collect-an-http-quoted-string
+func readQuotedString(s string) (string, string) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				b.WriteByte('\\')
+				return b.String(), ""
+			}
+			i++
+			b.WriteByte(s[i])
+		case '"':
+			return b.String(), s[i+1:]
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String(), ""
+}
+
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if !isASCIIAlphanumeric(r) && !strings.ContainsRune("!#$%&'*+-.^_`|~", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// forgivingBase64Decode decodes base64 ignoring ASCII whitespace and optional
+// padding.
+// https://infra.spec.whatwg.org/#forgiving-base64-decode
+func forgivingBase64Decode(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(asciiWhitespace, r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	if len(s)%4 == 0 {
+		s = strings.TrimSuffix(s, "=")
+		s = strings.TrimSuffix(s, "=")
+	}
+
+	data, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("data URI payload is not valid base64")
+	}
+
+	return data, nil
+}
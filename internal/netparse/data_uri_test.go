@@ -0,0 +1,92 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDataURI(t *testing.T) {
+	tests := []struct {
+		input string
+		want  DataURIModel
+	}{
+		{
+			input: "data:,Hello%2C%20World%21",
+			want: DataURIModel{
+				MediaType: "text/plain",
+				Params:    map[string]string{"charset": "US-ASCII"},
+				Data:      []byte("Hello, World!"),
+			},
+		},
+		{
+			input: "data:text/plain;base64,SGVsbG8sIFdvcmxkIQ==",
+			want: DataURIModel{
+				MediaType: "text/plain",
+				Params:    map[string]string{},
+				Base64:    true,
+				Data:      []byte("Hello, World!"),
+			},
+		},
+		{
+			input: "data:Text/HTML;Charset=\"utf-8\";base64,PGgxPkhlbGxvPC9oMT4#ignored",
+			want: DataURIModel{
+				MediaType: "text/html",
+				Params:    map[string]string{"charset": "utf-8"},
+				Base64:    true,
+				Data:      []byte("<h1>Hello</h1>"),
+			},
+		},
+		{
+			input: "data:;charset=utf-8,%E2%9C%93",
+			want: DataURIModel{
+				MediaType: "text/plain",
+				Params:    map[string]string{"charset": "utf-8"},
+				Data:      []byte("✓"),
+			},
+		},
+		{
+			input: "data:image/png;base64,iVBO Rw0K\nGgo=",
+			want: DataURIModel{
+				MediaType: "image/png",
+				Params:    map[string]string{},
+				Base64:    true,
+				Data:      []byte("\x89PNG\r\n\x1a\n"),
+			},
+		},
+		{
+			input: "data:invalid,x",
+			want: DataURIModel{
+				MediaType: "text/plain",
+				Params:    map[string]string{"charset": "US-ASCII"},
+				Data:      []byte("x"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDataURI(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDataURIError(t *testing.T) {
+	for _, input := range []string{
+		"https://example.com",
+		"data:text/plain",
+		"data:;base64,a",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseDataURI(input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,477 @@
+package netparse
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DSNModel describes a database connection string.
+// References used.
+// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
+// https://github.com/go-sql-driver/mysql#dsn-data-source-name
+// https://learn.microsoft.com/en-us/dotnet/api/system.data.sqlclient.sqlconnection.connectionstring
+// https://learn.microsoft.com/en-us/sql/connect/jdbc/building-the-connection-url
+type DSNModel struct {
+	Driver string
+	Hosts  []string
+	// Ports has the port of each host. The default port of the driver is used
+	// when a host has no port, or 0 when the driver has none.
+	Ports    []int
+	Database string
+	User     string
+	Password string
+	Params   map[string]string
+}
+
+// mysqlDSNPattern matches the go-sql-driver/mysql format
+// [username[:password]@][protocol[(address)]]/dbname[?params].
+var mysqlDSNPattern = regexp.MustCompile(`^(?:(.*)@)?(?:(\w+)(?:\((.*)\))?)?/([^?]*)(?:\?(.*))?$`)
+
+// driverAliases maps alternative names of a driver to the name used in
+// DSNModel.Driver.
+var driverAliases = map[string]string{
+	"postgresql": "postgres",
+	"pgx":        "postgres",
+	"mssql":      "sqlserver",
+	"mariadb":    "mysql",
+}
+
+// ParseDSN parses a database connection string. It accepts URLs, like
+// postgres://user@host/db, JDBC URLs, go-sql-driver/mysql DSNs, PostgreSQL
+// key=value connection strings and SQL Server connection strings. The errors
+// don't include the connection string, since it can have a password.
+func ParseDSN(dsn string) (*DSNModel, error) {
+	var (
+		model *DSNModel
+		err   error
+	)
+
+	switch {
+	case strings.HasPrefix(strings.ToLower(dsn), "jdbc:sqlserver:"):
+		model, err = parseJDBCSQLServerDSN(dsn[len("jdbc:"):])
+	case strings.HasPrefix(strings.ToLower(dsn), "jdbc:"):
+		model, err = parseURLDSN(dsn[len("jdbc:"):])
+	case strings.Contains(dsn, "://"):
+		model, err = parseURLDSN(dsn)
+	case mysqlDSNPattern.MatchString(dsn):
+		model, err = parseMySQLDSN(dsn)
+	case hasUnquotedSemicolon(dsn):
+		model, err = parseSQLServerDSN(dsn)
+	case strings.Contains(dsn, "="):
+		model, err = parsePostgresDSN(dsn)
+	default:
+		return nil, errors.New("unsupported DSN format")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	model.promoteParams()
+
+	return model, nil
+}
+
+// parseURLDSN parses a URL DSN. The authority can list several hosts
+// separated by commas, like PostgreSQL and MySQL allow.
+func parseURLDSN(dsn string) (*DSNModel, error) {
+	match := uriReferencePattern.FindStringSubmatch(dsn)
+	if match[2] == "" || match[3] == "" {
+		return nil, errors.New("DSN is not a URL with an authority")
+	}
+
+	authority := match[4]
+	var userinfo string
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		userinfo, authority = authority[:i+1], authority[i+1:]
+	}
+	addresses := strings.Split(authority, ",")
+
+	// The first host stands for all of them, so that the rest of the URL is
+	// parsed by ParseURL.
+	u, err := ParseURL(match[1] + "//" + userinfo + addresses[0] + match[5] + match[6] + match[8])
+	if err != nil {
+		// The error of net/url quotes the URL, which can have a password.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return nil, fmt.Errorf("invalid DSN URL: %w", urlErr.Err)
+		}
+		return nil, err
+	}
+
+	model := &DSNModel{
+		Driver:   normalizeDriver(u.Scheme),
+		Database: strings.TrimPrefix(u.Path, "/"),
+		User:     u.Username,
+		Password: u.Password,
+		Params:   map[string]string{},
+	}
+
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		if err := model.addAddress(address); err != nil {
+			return nil, err
+		}
+	}
+
+	// The names and values are decoded the same way, so + is a space in both.
+	params, err := url.ParseQuery(u.Query)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range params {
+		model.Params[name] = values[len(values)-1]
+	}
+
+	return model, nil
+}
+
+// parseMySQLDSN parses a go-sql-driver/mysql DSN.
+func parseMySQLDSN(dsn string) (*DSNModel, error) {
+	match := mysqlDSNPattern.FindStringSubmatch(dsn)
+
+	user, password, _ := strings.Cut(match[1], ":")
+	model := &DSNModel{
+		Driver:   "mysql",
+		Database: match[4],
+		User:     user,
+		Password: password,
+		Params:   map[string]string{},
+	}
+
+	switch protocol, address := match[2], match[3]; {
+	case protocol == "unix":
+		model.Hosts = append(model.Hosts, address)
+		model.Ports = append(model.Ports, 0)
+	case address != "":
+		if err := model.addAddress(address); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, param := range strings.Split(match[5], "&") {
+		if param == "" {
+			continue
+		}
+		name, value, _ := strings.Cut(param, "=")
+		unescaped, err := url.QueryUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+		model.Params[name] = unescaped
+	}
+
+	return model, nil
+}
+
+// parsePostgresDSN parses a PostgreSQL key=value connection string. Values can
+// be enclosed in single quotes, and a backslash escapes the next character.
+func parsePostgresDSN(dsn string) (*DSNModel, error) {
+	model := &DSNModel{
+		Driver: "postgres",
+		Params: map[string]string{},
+	}
+
+	var hosts, ports []string
+	s := dsn
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			break
+		}
+
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("missing \"=\" at offset %d in connection string", len(dsn)-len(s))
+		}
+		key := strings.TrimSpace(s[:i])
+		s = strings.TrimLeftFunc(s[i+1:], unicode.IsSpace)
+
+		value, rest, err := readConninfoValue(s)
+		if err != nil {
+			return nil, err
+		}
+		s = rest
+
+		switch key {
+		case "host":
+			hosts = strings.Split(value, ",")
+		case "port":
+			ports = strings.Split(value, ",")
+		case "dbname":
+			model.Database = value
+		case "user":
+			model.User = value
+		case "password":
+			model.Password = value
+		default:
+			model.Params[key] = value
+		}
+	}
+
+	// The hostaddr is only used as the host when no host name is set.
+	if hostaddr, ok := model.Params["hostaddr"]; ok && len(hosts) == 0 {
+		hosts = strings.Split(hostaddr, ",")
+		delete(model.Params, "hostaddr")
+	}
+
+	// Without a host, the port selects the Unix-domain socket, so it's kept as
+	// a parameter.
+	if len(hosts) == 0 && len(ports) > 0 {
+		model.Params["port"] = strings.Join(ports, ",")
+	}
+
+	if len(hosts) > 0 && len(ports) > 1 && len(ports) != len(hosts) {
+		return nil, fmt.Errorf("could not match %d port numbers to %d hosts", len(ports), len(hosts))
+	}
+
+	for i, host := range hosts {
+		var port string
+		if len(ports) == 1 {
+			port = ports[0]
+		} else if len(ports) > 1 {
+			port = ports[i]
+		}
+		if err := model.addHostPort(host, port); err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+func readConninfoValue(s string) (string, string, error) {
+	var b strings.Builder
+
+	if strings.HasPrefix(s, "'") {
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++
+				if i < len(s) {
+					b.WriteByte(s[i])
+				}
+			case '\'':
+				return b.String(), s[i+1:], nil
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+
+		return "", "", errors.New("unterminated quoted string in connection string")
+	}
+
+	i := 0
+	for ; i < len(s) && !unicode.IsSpace(rune(s[i])); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String(), s[i:], nil
+}
+
+// parseSQLServerDSN parses a SQL Server connection string of
+// semicolon-separated key=value pairs.
+func parseSQLServerDSN(dsn string) (*DSNModel, error) {
+	model := &DSNModel{
+		Driver: "sqlserver",
+		Params: map[string]string{},
+	}
+
+	for i, pair := range splitSQLServerPairs(dsn) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("missing \"=\" in pair %d of connection string", i+1)
+		}
+		key, value = strings.TrimSpace(key), unquoteSQLServerValue(strings.TrimSpace(value))
+
+		switch strings.ToLower(key) {
+		case "server", "data source", "address", "addr", "network address":
+			if err := model.addSQLServerAddress(value); err != nil {
+				return nil, err
+			}
+		case "database", "initial catalog":
+			model.Database = value
+		case "user id", "uid", "user":
+			model.User = value
+		case "password", "pwd":
+			model.Password = value
+		default:
+			model.Params[key] = value
+		}
+	}
+
+	return model, nil
+}
+
+// parseJDBCSQLServerDSN parses a SQL Server JDBC URL without its jdbc: prefix,
+// like sqlserver://host\instance:1433;databaseName=db;user=sa.
+func parseJDBCSQLServerDSN(dsn string) (*DSNModel, error) {
+	address, properties, _ := strings.Cut(dsn[len("sqlserver:"):], ";")
+
+	model, err := parseSQLServerDSN(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	if address = strings.TrimPrefix(address, "//"); address != "" {
+		host, port := address, ""
+		if i := strings.LastIndex(address, ":"); i >= 0 && !strings.Contains(address[i:], "]") {
+			host, port = address[:i], address[i+1:]
+		}
+		model.Hosts, model.Ports = nil, nil
+		if err := model.addHostPort(host, port); err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+// hasUnquotedSemicolon reports whether s has a semicolon that is not inside
+// braces or quotes, so a PostgreSQL connection string with a quoted value like
+// password='se;cret' is not mistaken for a SQL Server one.
+func hasUnquotedSemicolon(s string) bool {
+	return len(splitUnquotedSemicolons(s)) > 1
+}
+
+// splitSQLServerPairs splits a SQL Server connection string by semicolons
+// that are not inside braces or quotes, dropping the empty pairs.
+func splitSQLServerPairs(s string) []string {
+	pairs := splitUnquotedSemicolons(s)
+
+	result := pairs[:0]
+	for _, pair := range pairs {
+		if strings.TrimSpace(pair) != "" {
+			result = append(result, pair)
+		}
+	}
+
+	return result
+}
+
+// splitUnquotedSemicolons splits s by semicolons that are not inside braces
+// or quotes.
+func splitUnquotedSemicolons(s string) []string {
+	var (
+		pairs []string
+		quote byte
+		start int
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '{':
+			quote = '}'
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ';':
+			pairs = append(pairs, s[start:i])
+			start = i + 1
+		}
+	}
+	pairs = append(pairs, s[start:])
+
+	return pairs
+}
+
+func unquoteSQLServerValue(value string) string {
+	if len(value) >= 2 {
+		switch first, last := value[0], value[len(value)-1]; {
+		case first == '{' && last == '}', first == '"' && last == '"', first == '\'' && last == '\'':
+			return value[1 : len(value)-1]
+		}
+	}
+
+	return value
+}
+
+// addSQLServerAddress adds a SQL Server address, like tcp:host,1433 or
+// host\instance.
+func (d *DSNModel) addSQLServerAddress(address string) error {
+	if i := strings.Index(address, ":"); i >= 0 && !strings.Contains(address[:i], "[") && !strings.Contains(address[:i], ".") {
+		switch strings.ToLower(address[:i]) {
+		case "tcp", "np", "lpc", "admin":
+			address = address[i+1:]
+		}
+	}
+
+	host, port, _ := strings.Cut(address, ",")
+
+	return d.addHostPort(strings.TrimSpace(host), strings.TrimSpace(port))
+}
+
+// addAddress adds a host:port address. IPv6 hosts are enclosed in brackets.
+func (d *DSNModel) addAddress(address string) error {
+	host, port := address, ""
+	if i := strings.LastIndex(address, ":"); i >= 0 && !strings.Contains(address[i:], "]") {
+		host, port = address[:i], address[i+1:]
+	}
+
+	return d.addHostPort(host, port)
+}
+
+func (d *DSNModel) addHostPort(host string, port string) error {
+	p, _ := DefaultPort(d.Driver)
+	if port != "" {
+		var err error
+		if p, err = strconv.Atoi(port); err != nil || p < 0 || p > 65535 {
+			return fmt.Errorf("invalid port %q", port)
+		}
+	}
+
+	d.Hosts = append(d.Hosts, strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
+	d.Ports = append(d.Ports, p)
+
+	return nil
+}
+
+// promoteParams moves the user, password and database set as parameters to
+// their own fields, like JDBC URLs and SQL Server URLs do.
+func (d *DSNModel) promoteParams() {
+	fields := []struct {
+		field *string
+		names []string
+	}{
+		{&d.User, []string{"user", "username"}},
+		{&d.Password, []string{"password"}},
+		{&d.Database, []string{"database", "databaseName", "dbname"}},
+	}
+
+	names := slices.Sorted(maps.Keys(d.Params))
+	for _, f := range fields {
+		for _, name := range names {
+			if *f.field != "" {
+				break
+			}
+
+			if _, ok := d.Params[name]; ok && slices.ContainsFunc(f.names, func(n string) bool { return strings.EqualFold(n, name) }) {
+				*f.field = d.Params[name]
+				delete(d.Params, name)
+			}
+		}
+	}
+}
+
+func normalizeDriver(scheme string) string {
+	driver := strings.ToLower(scheme)
+	if alias, ok := driverAliases[driver]; ok {
+		return alias
+	}
+
+	return driver
+}
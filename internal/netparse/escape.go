@@ -0,0 +1,111 @@
+package netparse
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// URL components that values can be escaped for.
+const (
+	URLComponentPathSegment = "path-segment"
+	URLComponentPath        = "path"
+	URLComponentQueryKey    = "query-key"
+	URLComponentQueryValue  = "query-value"
+	URLComponentUserinfo    = "userinfo"
+	URLComponentFragment    = "fragment"
+	URLComponentHost        = "host"
+)
+
+// URLComponents are the components supported by EscapeURLComponent and
+// UnescapeURLComponent.
+var URLComponents = []string{
+	URLComponentPathSegment,
+	URLComponentPath,
+	URLComponentQueryKey,
+	URLComponentQueryValue,
+	URLComponentUserinfo,
+	URLComponentFragment,
+	URLComponentHost,
+}
+
+// componentPercentEncodeSets maps each URL component to the code points that
+// are percent-encoded in it. The sets extend the percent-encode sets of the
+// WHATWG URL Standard with % and the delimiters that end the component, so the
+// escaped value is always read back as the same value.
+// References used.
+// https://url.spec.whatwg.org/#percent-encoded-bytes
+// https://www.rfc-editor.org/rfc/rfc3986#section-2.2
+var componentPercentEncodeSets = map[string]percentEncodeSet{
+	URLComponentPathSegment: func(r rune) bool {
+		return inPathPercentEncodeSet(r) || strings.ContainsRune("%/\\", r)
+	},
+	URLComponentPath: func(r rune) bool {
+		return inPathPercentEncodeSet(r) || strings.ContainsRune("%\\", r)
+	},
+	URLComponentQueryKey: func(r rune) bool {
+		return inSpecialQueryPercentEncodeSet(r) || strings.ContainsRune("%&+=", r)
+	},
+	URLComponentQueryValue: func(r rune) bool {
+		return inSpecialQueryPercentEncodeSet(r) || strings.ContainsRune("%&+", r)
+	},
+	URLComponentUserinfo: func(r rune) bool {
+		return inUserinfoPercentEncodeSet(r) || r == '%'
+	},
+	URLComponentFragment: func(r rune) bool {
+		return inFragmentPercentEncodeSet(r) || r == '%'
+	},
+	URLComponentHost: func(r rune) bool {
+		return r > 0x7E || !isUnreserved(byte(r)) && !strings.ContainsRune("!$&'()*+,;=", r)
+	},
+}
+
+// EscapeURLComponent percent-encodes the value so that it can be used as the
+// component of a URL. Each component has its own set of characters to encode:
+// a path segment encodes /, a path keeps it, a query key encodes & + and =, a
+// query value keeps =, and the userinfo encodes : and @. A space is always
+// encoded as %20. A host keeps only the unreserved characters and the
+// sub-delimiters of a registered name, so domain names that are not ASCII
+// should be converted to punycode instead.
+func EscapeURLComponent(value string, component string) (string, error) {
+	set, ok := componentPercentEncodeSets[component]
+	if !ok {
+		return "", unsupportedURLComponentError(component)
+	}
+
+	return percentEncodeString(value, set), nil
+}
+
+// UnescapeURLComponent decodes the percent-encoded bytes of a URL component.
+// In a query key or value, a + is also decoded as a space, following the
+// application/x-www-form-urlencoded format. It fails when a % is not followed
+// by two hex digits or when the decoded value is not valid UTF-8.
+// References used.
+// https://url.spec.whatwg.org/#application/x-www-form-urlencoded
+func UnescapeURLComponent(value string, component string) (string, error) {
+	if _, ok := componentPercentEncodeSets[component]; !ok {
+		return "", unsupportedURLComponentError(component)
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] == '%' && (i+2 >= len(value) || !isHexDigit(value[i+1]) || !isHexDigit(value[i+2])) {
+			end := min(i+3, len(value))
+			return "", fmt.Errorf("invalid percent-encoding %q at offset %d", value[i:end], i)
+		}
+	}
+
+	if component == URLComponentQueryKey || component == URLComponentQueryValue {
+		value = strings.ReplaceAll(value, "+", " ")
+	}
+
+	decoded := percentDecode(value)
+	if !utf8.ValidString(decoded) {
+		return "", fmt.Errorf("decoded value %q is not valid UTF-8", decoded)
+	}
+
+	return decoded, nil
+}
+
+func unsupportedURLComponentError(component string) error {
+	return fmt.Errorf("unsupported URL component %q, expected one of: %s", component, strings.Join(URLComponents, ", "))
+}
@@ -0,0 +1,94 @@
+package netparse
+
+import "testing"
+
+func TestEscapeURLComponent(t *testing.T) {
+	tests := []struct {
+		value     string
+		component string
+		want      string
+	}{
+		{"a/b c?d#e%f", URLComponentPathSegment, "a%2Fb%20c%3Fd%23e%25f"},
+		{"/a/b c/ü", URLComponentPath, "/a/b%20c/%C3%BC"},
+		{`a\b`, URLComponentPath, "a%5Cb"},
+		{"a=b&c+d e", URLComponentQueryKey, "a%3Db%26c%2Bd%20e"},
+		{"a=b&c+d e'#", URLComponentQueryValue, "a=b%26c%2Bd%20e%27%23"},
+		{"ci-bot@example.com:p@ss", URLComponentUserinfo, "ci-bot%40example.com%3Ap%40ss"},
+		{"section 1#2", URLComponentFragment, "section%201#2"},
+		{"my host_1.local", URLComponentHost, "my%20host_1.local"},
+		{"bücher.example", URLComponentHost, "b%C3%BCcher.example"},
+		{"abc-._~", URLComponentUserinfo, "abc-._~"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component+" "+tt.value, func(t *testing.T) {
+			got, err := EscapeURLComponent(tt.value, tt.component)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			back, err := UnescapeURLComponent(got, tt.component)
+			if err != nil {
+				t.Fatalf("unexpected error unescaping: %v", err)
+			}
+
+			if back != tt.value {
+				t.Errorf("got %q after a round trip, want %q", back, tt.value)
+			}
+		})
+	}
+}
+
+func TestUnescapeURLComponent(t *testing.T) {
+	tests := []struct {
+		value     string
+		component string
+		want      string
+	}{
+		{"a%2Fb", URLComponentPathSegment, "a/b"},
+		{"a+b", URLComponentPath, "a+b"},
+		{"a+b%2Bc", URLComponentQueryValue, "a b+c"},
+		{"caf%c3%a9", URLComponentFragment, "café"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component+" "+tt.value, func(t *testing.T) {
+			got, err := UnescapeURLComponent(tt.value, tt.component)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeURLComponentError(t *testing.T) {
+	tests := []struct {
+		value     string
+		component string
+	}{
+		{"100%", URLComponentPath},
+		{"%zz", URLComponentQueryValue},
+		{"%C3", URLComponentPathSegment},
+		{"a", "scheme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component+" "+tt.value, func(t *testing.T) {
+			if _, err := UnescapeURLComponent(tt.value, tt.component); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	if _, err := EscapeURLComponent("a", "query"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,143 @@
+package netparse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FileURLOptions configures FileURLToPath and PathToFileURL.
+type FileURLOptions struct {
+	// Windows uses Windows paths, with a drive letter or a UNC share and \
+	// separators, instead of POSIX paths.
+	Windows bool
+}
+
+// FileURLToPath converts a file URL to a filesystem path. The path is
+// percent-decoded, and the query and fragment are ignored. POSIX paths
+// require an empty or localhost host. Windows paths are either a drive letter
+// path, like C:\Users from file:///C:/Users, or a UNC path, like
+// \\server\share from file://server/share. The conversion doesn't depend on
+// the operating system, like the fileURLToPath function of Node.js.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc8089
+// https://nodejs.org/api/url.html#urlfileurltopathurl-options
+func FileURLToPath(u string, opts FileURLOptions) (string, error) {
+	parsed, err := parseWHATWG(u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if parsed.scheme != "file" {
+		return "", fmt.Errorf("URL %q doesn't have the file scheme", u)
+	}
+
+	host, pathname := parsed.hostname(), parsed.pathname()
+
+	if !opts.Windows {
+		if host != "" {
+			return "", fmt.Errorf("file URL host must be empty or localhost for POSIX paths, got %q", host)
+		}
+
+		if hasEncodedSeparator(pathname, "2f") {
+			return "", fmt.Errorf("file URL path must not include encoded / characters")
+		}
+
+		return percentDecode(pathname), nil
+	}
+
+	if hasEncodedSeparator(pathname, "2f", "5c") {
+		return "", fmt.Errorf("file URL path must not include encoded / or \\ characters")
+	}
+
+	path := strings.ReplaceAll(percentDecode(pathname), "/", `\`)
+
+	if host != "" {
+		return `\\` + host + path, nil
+	}
+
+	if len(path) < 3 || !isASCIIAlpha(rune(path[1])) || path[2] != ':' {
+		return "", fmt.Errorf("file URL path must be absolute for Windows paths")
+	}
+
+	return path[1:], nil
+}
+
+// PathToFileURL converts an absolute filesystem path to a file URL. The
+// characters that are not allowed in a URL path, and %, are percent-encoded,
+// and . and .. segments are resolved. POSIX paths must start with /. Windows
+// paths use \ or / as separators and must start with a drive letter, like
+// C:\Users, or a UNC share, like \\server\share, whose server becomes the host
+// of the URL. The \\?\ prefix of long paths is removed.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc8089
+// https://nodejs.org/api/url.html#urlpathtofileurlpath-options
+func PathToFileURL(path string, opts FileURLOptions) (string, error) {
+	var href string
+
+	if !opts.Windows {
+		if !strings.HasPrefix(path, "/") {
+			return "", fmt.Errorf("path %q is not absolute", path)
+		}
+
+		href = "file://" + percentEncodeString(path, inPOSIXFilePathPercentEncodeSet)
+	} else {
+		slashed := strings.ReplaceAll(path, `\`, "/")
+		if rest, ok := strings.CutPrefix(slashed, "//?/"); ok {
+			slashed = rest
+			if share, ok := strings.CutPrefix(rest, "UNC/"); ok {
+				slashed = "//" + share
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(slashed, "//"):
+			host, share, _ := strings.Cut(slashed[2:], "/")
+			if host == "" || share == "" {
+				return "", fmt.Errorf("UNC path %q must have a server and a share", path)
+			}
+
+			href = "file://" + host + "/" + percentEncodeString(share, inWindowsFilePathPercentEncodeSet)
+		case len(slashed) >= 2 && isASCIIAlpha(rune(slashed[0])) && slashed[1] == ':' && (len(slashed) == 2 || slashed[2] == '/'):
+			href = "file:///" + percentEncodeString(slashed, inWindowsFilePathPercentEncodeSet)
+		default:
+			return "", fmt.Errorf("path %q is not absolute", path)
+		}
+	}
+
+	parsed, err := parseWHATWG(href, nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %q: %w", path, err)
+	}
+
+	return parsed.href(), nil
+}
+
+// hasEncodedSeparator reports whether the path has a percent-encoded byte
+// with any of the hex values, matched case-insensitively.
+func hasEncodedSeparator(path string, hexes ...string) bool {
+	for i := 0; i+2 < len(path); i++ {
+		if path[i] != '%' {
+			continue
+		}
+
+		for _, h := range hexes {
+			if strings.EqualFold(path[i+1:i+3], h) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// inPOSIXFilePathPercentEncodeSet adds % and \ to the path percent-encode set,
+// because they are literal characters of a POSIX path.
+func inPOSIXFilePathPercentEncodeSet(r rune) bool {
+	return inWindowsFilePathPercentEncodeSet(r) || r == '\\'
+}
+
+// inWindowsFilePathPercentEncodeSet adds % to the path percent-encode set, so
+// that the path is not decoded when the URL is converted back.
+func inWindowsFilePathPercentEncodeSet(r rune) bool {
+	return inPathPercentEncodeSet(r) || r == '%'
+}
@@ -0,0 +1,120 @@
+package netparse
+
+import "testing"
+
+func TestFileURLToPath(t *testing.T) {
+	tests := []struct {
+		input   string
+		windows bool
+		want    string
+	}{
+		{"file:///etc/hosts", false, "/etc/hosts"},
+		{"file://localhost/var/log/app%20name.log", false, "/var/log/app name.log"},
+		{"FILE:///tmp/caf%C3%A9/?q=1#top", false, "/tmp/café/"},
+		{"file:///tmp/a%5Cb", false, `/tmp/a\b`},
+		{"file:///C:/Users/me/My%20Documents/a.txt", true, `C:\Users\me\My Documents\a.txt`},
+		{"file:///c|/windows", true, `c:\windows`},
+		{"file:C:/build/out", true, `C:\build\out`},
+		{"file://server/share/dir/file.txt", true, `\\server\share\dir\file.txt`},
+		{"file://localhost/D:/data", true, `D:\data`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := FileURLToPath(tt.input, FileURLOptions{Windows: tt.windows})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileURLToPathError(t *testing.T) {
+	tests := []struct {
+		input   string
+		windows bool
+	}{
+		{"https://example.com/a", false},
+		{"file://server/share", false},
+		{"file:///tmp/a%2Fb", false},
+		{"file:///C:/a%5cb", true},
+		{"file:///etc/hosts", true},
+		{"file://exa mple/share", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if _, err := FileURLToPath(tt.input, FileURLOptions{Windows: tt.windows}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestPathToFileURL(t *testing.T) {
+	tests := []struct {
+		input   string
+		windows bool
+		want    string
+	}{
+		{"/etc/hosts", false, "file:///etc/hosts"},
+		{"/tmp/app name/100%/a#b?c.txt", false, "file:///tmp/app%20name/100%25/a%23b%3Fc.txt"},
+		{"/tmp/café/", false, "file:///tmp/caf%C3%A9/"},
+		{`/tmp/a\b`, false, "file:///tmp/a%5Cb"},
+		{"/srv/./app/../data", false, "file:///srv/data"},
+		{`C:\Users\me\My Documents\a.txt`, true, "file:///C:/Users/me/My%20Documents/a.txt"},
+		{"d:/data", true, "file:///d:/data"},
+		{`C:`, true, "file:///C:"},
+		{`\\Server\share\dir\file.txt`, true, "file://server/share/dir/file.txt"},
+		{`\\?\C:\very\long\path`, true, "file:///C:/very/long/path"},
+		{`\\?\UNC\server\share\a`, true, "file://server/share/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := PathToFileURL(tt.input, FileURLOptions{Windows: tt.windows})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			path, err := FileURLToPath(got, FileURLOptions{Windows: tt.windows})
+			if err != nil {
+				t.Fatalf("unexpected error converting back: %v", err)
+			}
+
+			if back, _ := PathToFileURL(path, FileURLOptions{Windows: tt.windows}); back != got {
+				t.Errorf("got %q after a round trip, want %q", back, got)
+			}
+		})
+	}
+}
+
+func TestPathToFileURLError(t *testing.T) {
+	tests := []struct {
+		input   string
+		windows bool
+	}{
+		{"relative/path", false},
+		{`C:\Users`, false},
+		{"/etc/hosts", true},
+		{`Users\me`, true},
+		{`\\server`, true},
+		{`\\exa mple\share`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if _, err := PathToFileURL(tt.input, FileURLOptions{Windows: tt.windows}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package netparse
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GitTransportSSH is the transport of ssh URLs and the scp-like syntax.
+	GitTransportSSH = "ssh"
+	// GitTransportHTTPS is the transport of https URLs.
+	GitTransportHTTPS = "https"
+	// GitTransportHTTP is the transport of http URLs.
+	GitTransportHTTP = "http"
+	// GitTransportGit is the transport of the git daemon protocol.
+	GitTransportGit = "git"
+	// GitTransportFile is the transport of local repositories.
+	GitTransportFile = "file"
+)
+
+// gitTransports maps each URL scheme accepted for git remotes to its transport.
+var gitTransports = map[string]string{
+	"ssh":     GitTransportSSH,
+	"git+ssh": GitTransportSSH,
+	"ssh+git": GitTransportSSH,
+	"https":   GitTransportHTTPS,
+	"http":    GitTransportHTTP,
+	"git":     GitTransportGit,
+	"file":    GitTransportFile,
+}
+
+// GitURLModel describes a git remote URL.
+type GitURLModel struct {
+	// Transport is the protocol used to reach the remote. It can be one of:
+	// ssh, https, http, git or file. The scp-like syntax uses ssh.
+	Transport string
+	// User is the user of the remote, like git in git@github.com:org/repo.git.
+	User string
+	// Host is the host of the remote. IPv6 addresses are not enclosed in
+	// brackets. It's empty for the file transport.
+	Host string
+	// Port is the port of the remote, or 0 when not set.
+	Port int
+	// Owner is the path of the namespace that contains the repository, like
+	// an organization or a group with subgroups.
+	Owner string
+	// Repo is the name of the repository, without the .git suffix.
+	Repo string
+	// AbsolutePath is whether the path of an ssh remote is absolute, like
+	// /srv/git/repo.git in git@host:/srv/git/repo.git, instead of relative
+	// to the home directory of the user, like org/repo.git in
+	// git@host:org/repo.git or ~user/repo.git in ssh://host/~user/repo.git.
+	AbsolutePath bool
+	// HTTPSURL is the remote using the https transport. It's empty for the
+	// file transport.
+	HTTPSURL string
+	// SSHURL is the remote using the ssh transport, in the scp-like syntax
+	// when the port is the default one. It's empty for the file transport.
+	SSHURL string
+}
+
+// ParseGitURL parses a git remote URL. It accepts the ssh, git+ssh, https,
+// http, git and file schemes, and the scp-like syntax [user@]host:path.
+// References used.
+// https://git-scm.com/docs/git-clone#_git_urls
+func ParseGitURL(u string) (*GitURLModel, error) {
+	model := &GitURLModel{}
+
+	var path string
+	if scheme, rest, ok := strings.Cut(u, "://"); ok {
+		model.Transport, ok = gitTransports[strings.ToLower(scheme)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported git URL scheme %q", scheme)
+		}
+
+		internalURL, err := url.Parse(strings.ToLower(scheme) + "://" + rest)
+		if err != nil {
+			return nil, err
+		}
+
+		model.User = internalURL.User.Username()
+		model.Host = internalURL.Hostname()
+		path = internalURL.Path
+
+		if port := internalURL.Port(); port != "" {
+			if model.Port, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("invalid port %q: %w", port, err)
+			}
+		}
+	} else {
+		var err error
+		if model.User, model.Host, path, err = parseSCPLikeGitURL(u); err != nil {
+			return nil, err
+		}
+		model.Transport = GitTransportSSH
+	}
+
+	if model.Transport != GitTransportFile && model.Host == "" {
+		return nil, fmt.Errorf("git URL %q has no host", u)
+	}
+
+	// Paths of ssh URLs are absolute unless they start with /~, and paths in
+	// the scp-like syntax are relative unless they start with /.
+	model.AbsolutePath = model.Transport == GitTransportSSH && strings.HasPrefix(path, "/") && !strings.HasPrefix(path, "/~")
+
+	path = strings.Trim(path, "/")
+	if i := strings.LastIndexByte(path, '/'); i >= 0 {
+		model.Owner = path[:i]
+		path = path[i+1:]
+	}
+	model.Repo = strings.TrimSuffix(path, ".git")
+
+	if model.Repo == "" {
+		return nil, fmt.Errorf("git URL %q has no repository", u)
+	}
+
+	if model.Transport != GitTransportFile {
+		model.HTTPSURL = model.httpsURL()
+		model.SSHURL = model.sshURL()
+	}
+
+	return model, nil
+}
+
+// parseSCPLikeGitURL splits a git remote in the scp-like syntax
+// [user@]host:path into its user, host and path.
+func parseSCPLikeGitURL(u string) (string, string, string, error) {
+	var user string
+	address := u
+	if i := strings.IndexByte(u, '@'); i >= 0 && !strings.Contains(u[:i], "/") {
+		user, address = u[:i], u[i+1:]
+	}
+
+	var host, path string
+	if strings.HasPrefix(address, "[") {
+		end := strings.Index(address, "]:")
+		if end < 0 {
+			return "", "", "", fmt.Errorf("git URL %q has an unclosed IPv6 host", u)
+		}
+		host, path = address[1:end], address[end+2:]
+	} else {
+		var ok bool
+		host, path, ok = strings.Cut(address, ":")
+		if !ok || strings.Contains(host, "/") {
+			return "", "", "", fmt.Errorf("git URL %q is neither a URL nor in the scp-like syntax user@host:path", u)
+		}
+	}
+
+	return user, host, path, nil
+}
+
+// repoPath returns the path of the repository with the .git suffix.
+func (m *GitURLModel) repoPath() string {
+	if m.Owner == "" {
+		return m.Repo + ".git"
+	}
+
+	return m.Owner + "/" + m.Repo + ".git"
+}
+
+// httpsURL renders the remote with the https transport. The port is only kept
+// when it's set in an https URL, because other transports listen on other
+// ports.
+func (m *GitURLModel) httpsURL() string {
+	host := m.Host
+	if m.Transport == GitTransportHTTPS && m.Port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(m.Port))
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	return "https://" + host + "/" + m.repoPath()
+}
+
+// sshURL renders the remote with the ssh transport. The user of an ssh remote
+// is kept, and git is used for the other transports. An ssh URL is used when
+// the port is not the default one or the path is absolute, because the path
+// of the scp-like syntax is relative to the home directory.
+func (m *GitURLModel) sshURL() string {
+	user := "git"
+	if m.Transport == GitTransportSSH && m.User != "" {
+		user = m.User
+	}
+
+	host := m.Host
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	if m.Transport == GitTransportSSH && m.Port != 0 && m.Port != schemeDefaultPorts["ssh"] {
+		return "ssh://" + user + "@" + net.JoinHostPort(m.Host, strconv.Itoa(m.Port)) + "/" + m.repoPath()
+	}
+
+	if m.AbsolutePath {
+		return "ssh://" + user + "@" + host + "/" + m.repoPath()
+	}
+
+	return user + "@" + host + ":" + m.repoPath()
+}
@@ -0,0 +1,103 @@
+package netparse
+
+import (
+	"net/netip"
+	"strings"
+)
+
+const (
+	// HostTypeIPv4 is the type of a host that is an IPv4 address.
+	HostTypeIPv4 = "ipv4"
+	// HostTypeIPv6 is the type of a host that is an IPv6 address.
+	HostTypeIPv6 = "ipv6"
+	// HostTypeDomain is the type of a host that is a domain name.
+	HostTypeDomain = "domain"
+	// HostTypeOpaque is the type of a host that is neither an IP address nor a
+	// domain name, like the registered names of some non-special schemes.
+	HostTypeOpaque = "opaque"
+)
+
+// classifyHost returns the type of the host, the zone when it's a scoped
+// IPv6 address, and the breakdown of the domain when it's a domain name. The
+// host may be enclosed in brackets. The type is empty when the host is empty.
+func classifyHost(host string) (string, string, *DomainModel) {
+	if host == "" {
+		return "", "", nil
+	}
+
+	if addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")); err == nil {
+		if addr.Is4() {
+			return HostTypeIPv4, "", nil
+		}
+
+		return HostTypeIPv6, addr.Zone(), nil
+	}
+
+	// Hosts like 2130706433, 0x7f.0.0.1 or 127.1 are IPv4 addresses for the
+	// WHATWG URL Standard and for most resolvers. Other hosts whose last
+	// label is a number, like 1.2.3.256, are not domain names.
+	if lower := strings.ToLower(host); whatwgEndsInANumber(lower) {
+		if _, err := parseWHATWGIPv4(lower); err == nil {
+			return HostTypeIPv4, "", nil
+		}
+
+		return HostTypeOpaque, "", nil
+	}
+
+	if !isDomainName(host) {
+		return HostTypeOpaque, "", nil
+	}
+
+	return HostTypeDomain, "", parseHostDomain(host)
+}
+
+// classifyWHATWGHost returns the type of a host parsed by the WHATWG host
+// parser and the breakdown of the domain when it's a domain name.
+func classifyWHATWGHost(host *whatwgHost) (string, *DomainModel) {
+	if host == nil {
+		return "", nil
+	}
+
+	switch host.kind {
+	case whatwgHostDomain:
+		return HostTypeDomain, parseHostDomain(host.value)
+	case whatwgHostIPv4:
+		return HostTypeIPv4, nil
+	case whatwgHostIPv6:
+		return HostTypeIPv6, nil
+	case whatwgHostOpaque:
+		return HostTypeOpaque, nil
+	default:
+		return "", nil
+	}
+}
+
+// parseHostDomain returns the breakdown of the domain name, or nil when it
+// has no registrable domain, like localhost or a public suffix.
+func parseHostDomain(host string) *DomainModel {
+	domain, err := ParseDomain(strings.ToLower(host))
+	if err != nil {
+		return nil
+	}
+
+	return domain
+}
+
+// isDomainName reports whether host is made of labels of letters, digits,
+// hyphens and underscores, or of internationalized labels. A trailing dot is
+// allowed.
+func isDomainName(host string) bool {
+	for _, label := range strings.Split(strings.TrimSuffix(host, "."), ".") {
+		if label == "" {
+			return false
+		}
+
+		for _, r := range label {
+			if r < 0x80 && !isASCIIAlphanumeric(r) && r != '-' && r != '_' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package netparse
+
+import "testing"
+
+func TestParseURLHostType(t *testing.T) {
+	tests := []struct {
+		input    string
+		hostType string
+		zone     string
+		domain   string
+	}{
+		{"https://10.0.0.1/", HostTypeIPv4, "", ""},
+		{"http://2130706433/", HostTypeIPv4, "", ""},
+		{"http://0X7F.0.0.1/", HostTypeIPv4, "", ""},
+		{"http://127.1/", HostTypeIPv4, "", ""},
+		{"http://017700000001/", HostTypeIPv4, "", ""},
+		{"http://1.2.3.256/", HostTypeOpaque, "", ""},
+		{"http://[fe80::1%25eth0]:8080/", HostTypeIPv6, "eth0", ""},
+		{"http://[2001:db8::1]/", HostTypeIPv6, "", ""},
+		{"https://app.example.co.uk/", HostTypeDomain, "", "example.co.uk"},
+		{"https://API.Example.com/", HostTypeDomain, "", "example.com"},
+		{"http://localhost:8080/", HostTypeDomain, "", ""},
+		{"postgres://db_primary.internal/", HostTypeDomain, "", "db_primary.internal"},
+		{"foo://a!b/", HostTypeOpaque, "", ""},
+		{"file:///etc/hosts", "", "", ""},
+		{"mailto:user@example.com", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseURL(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.HostType != tt.hostType {
+				t.Errorf("host type: got %q, want %q", got.HostType, tt.hostType)
+			}
+			if got.Zone != tt.zone {
+				t.Errorf("zone: got %q, want %q", got.Zone, tt.zone)
+			}
+
+			var domain string
+			if got.Domain != nil {
+				domain = got.Domain.Domain
+			}
+			if domain != tt.domain {
+				t.Errorf("domain: got %q, want %q", domain, tt.domain)
+			}
+		})
+	}
+}
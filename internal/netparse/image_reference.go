@@ -0,0 +1,178 @@
+package netparse
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultImageRegistry is the registry of image references that don't set
+	// one.
+	DefaultImageRegistry = "docker.io"
+	// defaultImageNamespace is the namespace of the official images in the
+	// default registry.
+	defaultImageNamespace = "library"
+	// maxImageNameLength is the maximum length of the name of an image,
+	// including the registry.
+	maxImageNameLength = 255
+)
+
+var (
+	// imageRepositoryPattern matches the path components of a repository.
+	// References used.
+	// https://github.com/distribution/reference/blob/main/reference.go
+	imageRepositoryPattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	imageTagPattern        = regexp.MustCompile(`^\w[\w.-]{0,127}$`)
+	imageDigestPattern     = regexp.MustCompile(`^([a-z0-9]+(?:[+._-][a-z0-9]+)*):([a-fA-F0-9]{32,})$`)
+	// imageDigestLengths are the lengths of the hex encoded digests of the
+	// registered algorithms.
+	// https://github.com/opencontainers/image-spec/blob/main/descriptor.md#registered-algorithms
+	imageDigestLengths = map[string]int{
+		"sha256": 64,
+		"sha512": 128,
+	}
+)
+
+// ImageReferenceModel describes a container image reference.
+type ImageReferenceModel struct {
+	// Registry is the host of the registry. IPv6 addresses are not enclosed
+	// in brackets. It's docker.io when the reference doesn't set one.
+	Registry string
+	// Port is the port of the registry, or 0 when not set.
+	Port int
+	// Repository is the path of the repository in the registry. Official
+	// images of docker.io are prefixed with library/.
+	Repository string
+	// Tag is the tag, or empty when not set.
+	Tag string
+	// DigestAlgorithm is the algorithm of the digest, like sha256, or empty
+	// when not set.
+	DigestAlgorithm string
+	// DigestHex is the hex encoded digest.
+	DigestHex string
+	// Name is the normalized name of the image, with the registry and the
+	// repository, like docker.io/library/nginx.
+	Name string
+}
+
+// ParseImageReference parses a container image reference following the
+// grammar of the distribution project, like
+// registry.example.com:5000/team/app:1.2@sha256:... The first path component
+// is the registry when it's localhost or has a dot, a port or an uppercase
+// letter, like distribution does. Otherwise, the registry is docker.io.
+// References used.
+// https://github.com/distribution/reference/blob/main/reference.go
+// https://github.com/distribution/reference/blob/main/normalize.go
+func ParseImageReference(ref string) (*ImageReferenceModel, error) {
+	model := &ImageReferenceModel{}
+
+	name, digest, hasDigest := strings.Cut(ref, "@")
+	if hasDigest {
+		match := imageDigestPattern.FindStringSubmatch(digest)
+		if match == nil {
+			return nil, fmt.Errorf("invalid digest %q", digest)
+		}
+		if length, ok := imageDigestLengths[match[1]]; ok && len(match[2]) != length {
+			return nil, fmt.Errorf("invalid %s digest %q, expected %d hex characters", match[1], match[2], length)
+		}
+		model.DigestAlgorithm = match[1]
+		model.DigestHex = match[2]
+	}
+
+	if i := strings.LastIndexByte(name, ':'); i > strings.LastIndexByte(name, '/') {
+		model.Tag = name[i+1:]
+		name = name[:i]
+		if !imageTagPattern.MatchString(model.Tag) {
+			return nil, fmt.Errorf("invalid tag %q", model.Tag)
+		}
+	}
+
+	if len(name) > maxImageNameLength {
+		return nil, fmt.Errorf("image name is %d characters long, the maximum is %d", len(name), maxImageNameLength)
+	}
+
+	model.Registry = DefaultImageRegistry
+	model.Repository = name
+	if registry, repository, ok := strings.Cut(name, "/"); ok && isImageRegistry(registry) {
+		host, port, err := splitImageRegistry(registry)
+		if err != nil {
+			return nil, err
+		}
+		model.Registry = host
+		model.Port = port
+		model.Repository = repository
+	}
+
+	if model.Registry == "index.docker.io" && model.Port == 0 {
+		model.Registry = DefaultImageRegistry
+	}
+
+	if !imageRepositoryPattern.MatchString(model.Repository) {
+		if strings.ToLower(model.Repository) != model.Repository {
+			return nil, fmt.Errorf("repository name %q must be lowercase", model.Repository)
+		}
+		return nil, fmt.Errorf("invalid repository name %q", model.Repository)
+	}
+
+	if model.Registry == DefaultImageRegistry && model.Port == 0 && !strings.Contains(model.Repository, "/") {
+		model.Repository = defaultImageNamespace + "/" + model.Repository
+	}
+
+	registry := model.Registry
+	if strings.Contains(registry, ":") {
+		registry = "[" + registry + "]"
+	}
+	if model.Port != 0 {
+		registry = net.JoinHostPort(model.Registry, strconv.Itoa(model.Port))
+	}
+	model.Name = registry + "/" + model.Repository
+
+	return model, nil
+}
+
+// isImageRegistry reports whether the first path component of an image name
+// is a registry rather than a repository path component. Like distribution,
+// the component is a registry when it's localhost or has a dot, a colon or an
+// uppercase letter, which repository path components can't have. So IP
+// addresses and domain names are registries, and a host name without a dot,
+// like registry, is not.
+func isImageRegistry(component string) bool {
+	return component == "localhost" || strings.ContainsAny(component, ".:") || strings.ToLower(component) != component
+}
+
+// splitImageRegistry splits a registry into its host and port. An IPv6
+// host must be enclosed in brackets.
+func splitImageRegistry(registry string) (string, int, error) {
+	host, port := registry, ""
+	if strings.HasPrefix(registry, "[") {
+		end := strings.IndexByte(registry, ']')
+		if end < 0 {
+			return "", 0, fmt.Errorf("invalid registry %q", registry)
+		}
+		if _, err := netip.ParseAddr(registry[1:end]); err != nil {
+			return "", 0, fmt.Errorf("invalid registry %q: %w", registry, err)
+		}
+		host, port = registry[1:end], registry[end+1:]
+		if port != "" && !strings.HasPrefix(port, ":") {
+			return "", 0, fmt.Errorf("invalid registry %q", registry)
+		}
+		port = strings.TrimPrefix(port, ":")
+	} else if i := strings.IndexByte(registry, ':'); i >= 0 {
+		host, port = registry[:i], registry[i+1:]
+	}
+
+	if port == "" {
+		return host, 0, nil
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil || p <= 0 || p > 65535 {
+		return "", 0, fmt.Errorf("invalid registry port %q", port)
+	}
+
+	return host, p, nil
+}
@@ -0,0 +1,150 @@
+package netparse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImageReference(t *testing.T) {
+	digest := strings.Repeat("a1", 32)
+
+	tests := []struct {
+		input string
+		want  ImageReferenceModel
+	}{
+		{
+			input: "nginx",
+			want: ImageReferenceModel{
+				Registry:   "docker.io",
+				Repository: "library/nginx",
+				Name:       "docker.io/library/nginx",
+			},
+		},
+		{
+			input: "bitnami/redis:7.2",
+			want: ImageReferenceModel{
+				Registry:   "docker.io",
+				Repository: "bitnami/redis",
+				Tag:        "7.2",
+				Name:       "docker.io/bitnami/redis",
+			},
+		},
+		{
+			input: "index.docker.io/alpine:3",
+			want: ImageReferenceModel{
+				Registry:   "docker.io",
+				Repository: "library/alpine",
+				Tag:        "3",
+				Name:       "docker.io/library/alpine",
+			},
+		},
+		{
+			input: "registry.example.com:5000/team/app:1.2@sha256:" + digest,
+			want: ImageReferenceModel{
+				Registry:        "registry.example.com",
+				Port:            5000,
+				Repository:      "team/app",
+				Tag:             "1.2",
+				DigestAlgorithm: "sha256",
+				DigestHex:       digest,
+				Name:            "registry.example.com:5000/team/app",
+			},
+		},
+		{
+			input: "ghcr.io/org/sub/image@sha256:" + digest,
+			want: ImageReferenceModel{
+				Registry:        "ghcr.io",
+				Repository:      "org/sub/image",
+				DigestAlgorithm: "sha256",
+				DigestHex:       digest,
+				Name:            "ghcr.io/org/sub/image",
+			},
+		},
+		{
+			input: "localhost/app:dev",
+			want: ImageReferenceModel{
+				Registry:   "localhost",
+				Repository: "app",
+				Tag:        "dev",
+				Name:       "localhost/app",
+			},
+		},
+		{
+			input: "[::1]:5000/app",
+			want: ImageReferenceModel{
+				Registry:   "::1",
+				Port:       5000,
+				Repository: "app",
+				Name:       "[::1]:5000/app",
+			},
+		},
+		{
+			input: "10.0.0.1/my_team/app-server__v2",
+			want: ImageReferenceModel{
+				Registry:   "10.0.0.1",
+				Repository: "my_team/app-server__v2",
+				Name:       "10.0.0.1/my_team/app-server__v2",
+			},
+		},
+		{
+			input: "co.uk/app",
+			want: ImageReferenceModel{
+				Registry:   "co.uk",
+				Repository: "app",
+				Name:       "co.uk/app",
+			},
+		},
+		{
+			input: "Foo/app",
+			want: ImageReferenceModel{
+				Registry:   "Foo",
+				Repository: "app",
+				Name:       "Foo/app",
+			},
+		},
+		{
+			input: "registry.internal/team/app",
+			want: ImageReferenceModel{
+				Registry:   "registry.internal",
+				Repository: "team/app",
+				Name:       "registry.internal/team/app",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseImageReference(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImageReferenceError(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"Nginx",
+		"Foo/App",
+		"nginx:",
+		"nginx:-latest",
+		"nginx@sha256:abc",
+		"nginx@sha256:" + strings.Repeat("a", 63),
+		"registry.example.com:http/app",
+		"team//app",
+		"app-",
+		strings.Repeat("a", 256),
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseImageReference(input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
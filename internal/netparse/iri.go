@@ -0,0 +1,156 @@
+package netparse
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// IRIToURI converts an internationalized resource identifier to a URI. A host
+// with non-ASCII characters is converted to ASCII with IDNA, like
+// xn--bcher-kva.example for bücher.example, and the other non-ASCII characters
+// are percent-encoded as UTF-8. The ASCII characters, including existing
+// percent-encodings, are kept as is.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc3987#section-3.1
+func IRIToURI(iri string) (string, error) {
+	if !utf8.ValidString(iri) {
+		return "", fmt.Errorf("IRI %q is not valid UTF-8", iri)
+	}
+
+	host, hasHost := urlSpans(iri)["host"]
+
+	var b strings.Builder
+	for i := 0; i < len(iri); i++ {
+		if hasHost && i == host.Start && host.End > host.Start {
+			asciiHost, err := iriHostToASCII(iri[host.Start:host.End])
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(asciiHost)
+			i = host.End - 1
+			continue
+		}
+
+		if iri[i] >= utf8.RuneSelf {
+			percentEncodeByte(&b, iri[i])
+			continue
+		}
+
+		b.WriteByte(iri[i])
+	}
+
+	return b.String(), nil
+}
+
+// URIToIRI converts a URI to an internationalized resource identifier for
+// display. The percent-encoded UTF-8 sequences are decoded only when they
+// encode characters allowed in an IRI, so the encodings of ASCII characters,
+// invalid UTF-8, bidirectional formatting characters and, outside the query,
+// private use characters are kept. The punycode labels of the host are
+// converted to Unicode.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc3987#section-3.2
+func URIToIRI(uri string) string {
+	spans := urlSpans(uri)
+	host, hasHost := spans["host"]
+	query, hasQuery := spans["query"]
+
+	var b strings.Builder
+	for i := 0; i < len(uri); {
+		if hasHost && i == host.Start && host.End > host.Start {
+			b.WriteString(iriHostToUnicode(uri[host.Start:host.End]))
+			i = host.End
+			continue
+		}
+
+		end := i
+		for end+2 < len(uri) && uri[end] == '%' && isHexDigit(uri[end+1]) && isHexDigit(uri[end+2]) && unhex(uri[end+1]) >= 8 {
+			end += 3
+		}
+
+		if end == i {
+			b.WriteByte(uri[i])
+			i++
+			continue
+		}
+
+		inQuery := hasQuery && query.Start <= i && i < query.End
+		writeIRIChars(&b, uri[i:end], inQuery)
+		i = end
+	}
+
+	return b.String()
+}
+
+// writeIRIChars decodes a run of percent-encoded non-ASCII bytes, keeping
+// encoded the bytes that are not valid UTF-8 or that encode a character not
+// allowed in an IRI.
+func writeIRIChars(b *strings.Builder, encoded string, inQuery bool) {
+	decoded := []byte(percentDecode(encoded))
+
+	for j := 0; j < len(decoded); {
+		r, size := utf8.DecodeRune(decoded[j:])
+		if r != utf8.RuneError && isIRIChar(r, inQuery) {
+			b.WriteRune(r)
+		} else {
+			b.WriteString(encoded[3*j : 3*(j+size)])
+		}
+		j += size
+	}
+}
+
+// isIRIChar reports whether r is a ucschar, or an iprivate character in the
+// query, that is not a bidirectional formatting character.
+// https://www.rfc-editor.org/rfc/rfc3987#section-2.2
+// https://www.rfc-editor.org/rfc/rfc3987#section-4.1
+func isIRIChar(r rune, inQuery bool) bool {
+	switch {
+	case r == 0x200E || r == 0x200F || 0x202A <= r && r <= 0x202E:
+		return false
+	case 0xA0 <= r && r <= 0xD7FF, 0xF900 <= r && r <= 0xFDCF, 0xFDF0 <= r && r <= 0xFFEF:
+		return true
+	case 0x10000 <= r && r <= 0xEFFFD:
+		return r&0xFFFF <= 0xFFFD && (r < 0xE0000 || r >= 0xE1000)
+	case 0xE000 <= r && r <= 0xF8FF, 0xF0000 <= r && r <= 0xFFFFD, 0x100000 <= r && r <= 0x10FFFD:
+		return inQuery
+	default:
+		return false
+	}
+}
+
+// iriHostToASCII converts a registered name with non-ASCII characters, which
+// can be percent-encoded, to ASCII. IP literals and ASCII names are kept as
+// is.
+func iriHostToASCII(host string) (string, error) {
+	if strings.HasPrefix(host, "[") {
+		return host, nil
+	}
+
+	decoded := percentDecode(host)
+	if isASCII(decoded) {
+		return host, nil
+	}
+
+	if !utf8.ValidString(decoded) {
+		return "", fmt.Errorf("invalid host %q: not valid UTF-8", host)
+	}
+
+	return domainToASCII(decoded)
+}
+
+// iriHostToUnicode converts the punycode labels of a host to Unicode. The
+// host is kept as is when it's an IP literal or the conversion fails.
+func iriHostToUnicode(host string) string {
+	if strings.HasPrefix(host, "[") || !hasPunycodeLabel(host) {
+		return host
+	}
+
+	unicodeHost, err := whatwgIDNA.ToUnicode(host)
+	if err != nil {
+		return host
+	}
+
+	return unicodeHost
+}
@@ -0,0 +1,103 @@
+package netparse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MailtoModel describes a mailto URI.
+type MailtoModel struct {
+	// To are the recipient addresses, from the path and the to header fields.
+	To []string
+	// Cc are the addresses of the cc header fields.
+	Cc []string
+	// Bcc are the addresses of the bcc header fields.
+	Bcc []string
+	// Subject is the value of the subject header field.
+	Subject string
+	// Body is the value of the body pseudo header field.
+	Body string
+	// Headers are the other header fields. Names are lowercase.
+	Headers map[string]string
+}
+
+// ParseMailto parses a mailto URI into its recipients and header fields. All
+// values are percent-decoded, and a "+" is kept as is. When a header field
+// other than to, cc or bcc is repeated, the last value is used. A fragment is
+// ignored.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc6068
+func ParseMailto(u string) (*MailtoModel, error) {
+	if len(u) < len("mailto:") || !strings.EqualFold(u[:len("mailto:")], "mailto:") {
+		return nil, fmt.Errorf("URI %q doesn't have the mailto scheme", u)
+	}
+	input, _, _ := strings.Cut(u[len("mailto:"):], "#")
+	to, hfields, _ := strings.Cut(input, "?")
+
+	model := &MailtoModel{
+		To:      []string{},
+		Cc:      []string{},
+		Bcc:     []string{},
+		Headers: map[string]string{},
+	}
+
+	// The addresses of the path are separated before decoding, so that an
+	// encoded comma can be part of an address.
+	for _, address := range strings.Split(to, ",") {
+		decoded, err := url.PathUnescape(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		if decoded = strings.TrimSpace(decoded); decoded != "" {
+			model.To = append(model.To, decoded)
+		}
+	}
+
+	for _, hfield := range strings.Split(hfields, "&") {
+		if hfield == "" {
+			continue
+		}
+
+		rawName, rawValue, _ := strings.Cut(hfield, "=")
+
+		name, err := url.PathUnescape(rawName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid header field name %q: %w", rawName, err)
+		}
+
+		value, err := url.PathUnescape(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of header field %q: %w", name, err)
+		}
+
+		switch name = strings.ToLower(name); name {
+		case "to":
+			model.To = appendAddresses(model.To, value)
+		case "cc":
+			model.Cc = appendAddresses(model.Cc, value)
+		case "bcc":
+			model.Bcc = appendAddresses(model.Bcc, value)
+		case "subject":
+			model.Subject = value
+		case "body":
+			model.Body = value
+		default:
+			model.Headers[name] = value
+		}
+	}
+
+	return model, nil
+}
+
+// appendAddresses appends the comma-separated addresses of list to addresses,
+// skipping empty ones.
+func appendAddresses(addresses []string, list string) []string {
+	for _, address := range strings.Split(list, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
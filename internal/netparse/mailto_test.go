@@ -0,0 +1,108 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMailto(t *testing.T) {
+	tests := []struct {
+		input string
+		want  MailtoModel
+	}{
+		{
+			input: "mailto:chris@example.com",
+			want: MailtoModel{
+				To:      []string{"chris@example.com"},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Headers: map[string]string{},
+			},
+		},
+		{
+			input: "mailto:infobot@example.com?subject=current-issue",
+			want: MailtoModel{
+				To:      []string{"infobot@example.com"},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Subject: "current-issue",
+				Headers: map[string]string{},
+			},
+		},
+		{
+			input: "MAILTO:ops@example.com,oncall@example.com?CC=lead@example.com&bcc=audit@example.com%2Carchive@example.com&to=sre@example.com&subject=Disk%20full%20on%20db-1&body=Usage%3A%2095%25%0D%0Aa+b",
+			want: MailtoModel{
+				To:      []string{"ops@example.com", "oncall@example.com", "sre@example.com"},
+				Cc:      []string{"lead@example.com"},
+				Bcc:     []string{"audit@example.com", "archive@example.com"},
+				Subject: "Disk full on db-1",
+				Body:    "Usage: 95%\r\na+b",
+				Headers: map[string]string{},
+			},
+		},
+		{
+			input: "mailto:%22not%40me%22@example.org",
+			want: MailtoModel{
+				To:      []string{`"not@me"@example.org`},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Headers: map[string]string{},
+			},
+		},
+		{
+			input: "mailto:%22unlikely%3Faddress%22@example.com?blat=foop",
+			want: MailtoModel{
+				To:      []string{`"unlikely?address"@example.com`},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Headers: map[string]string{"blat": "foop"},
+			},
+		},
+		{
+			input: "mailto:?to=joe@example.com&In-Reply-To=%3C3469A91.D10AF4C@example.com%3E",
+			want: MailtoModel{
+				To:      []string{"joe@example.com"},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Headers: map[string]string{"in-reply-to": "<3469A91.D10AF4C@example.com>"},
+			},
+		},
+		{
+			input: "mailto:user@%E2%9C%93.example?subject=%E2%9C%93#ignored",
+			want: MailtoModel{
+				To:      []string{"user@✓.example"},
+				Cc:      []string{},
+				Bcc:     []string{},
+				Subject: "✓",
+				Headers: map[string]string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseMailto(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMailtoError(t *testing.T) {
+	for _, input := range []string{
+		"https://example.com",
+		"mailto:user%ZZ@example.com",
+		"mailto:user@example.com?subject=%E",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseMailto(input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
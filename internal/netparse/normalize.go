@@ -0,0 +1,201 @@
+package netparse
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// uriReferencePattern splits a URI reference into its components.
+// https://www.rfc-editor.org/rfc/rfc3986#appendix-B
+var uriReferencePattern = regexp.MustCompile(`^(([^:/?#]+):)?(//([^/?#]*))?([^?#]*)(\?([^#]*))?(#(.*))?$`)
+
+// NormalizeURLOptions configures the optional normalizations of NormalizeURL.
+type NormalizeURLOptions struct {
+	// SortQuery sorts the query parameters by name. Parameters with the same
+	// name keep their order, and empty parameters are removed.
+	SortQuery bool
+	// RemoveEmptyFragment removes a "#" that is not followed by a fragment.
+	RemoveEmptyFragment bool
+}
+
+// NormalizeURL applies the syntax-based and scheme-based normalizations of
+// RFC 3986, so that equivalent URLs compare equal as strings. It lowercases
+// the scheme and host, removes default ports and dot-segments, uppercases the
+// hexadecimal digits of percent-encodings and decodes percent-encoded
+// unreserved characters.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc3986#section-6
+func NormalizeURL(u string, opts NormalizeURLOptions) (string, error) {
+	if _, err := url.Parse(u); err != nil {
+		return "", err
+	}
+
+	match := uriReferencePattern.FindStringSubmatch(u)
+	hasScheme, scheme := match[1] != "", strings.ToLower(match[2])
+	hasAuthority, authority := match[3] != "", match[4]
+	path := normalizePercentEncoding(match[5])
+	hasQuery, query := match[6] != "", normalizePercentEncoding(match[7])
+	hasFragment, fragment := match[8] != "", normalizePercentEncoding(match[9])
+
+	var b strings.Builder
+	if hasScheme {
+		b.WriteString(scheme + ":")
+	}
+
+	if hasAuthority {
+		b.WriteString("//" + normalizeAuthority(authority, scheme))
+
+		if path == "" && isSpecialScheme(scheme) {
+			path = "/"
+		}
+	}
+
+	if hasScheme || hasAuthority || strings.HasPrefix(path, "/") {
+		path = removeDotSegments(path)
+	}
+	b.WriteString(path)
+
+	if hasQuery {
+		if opts.SortQuery {
+			query = sortQuery(query)
+		}
+		b.WriteString("?" + query)
+	}
+
+	if hasFragment && !(opts.RemoveEmptyFragment && fragment == "") {
+		b.WriteString("#" + fragment)
+	}
+
+	return b.String(), nil
+}
+
+func normalizeAuthority(authority string, scheme string) string {
+	var userinfo string
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		userinfo, authority = normalizePercentEncoding(authority[:i])+"@", authority[i+1:]
+	}
+
+	host, port := authority, ""
+	if i := strings.LastIndex(authority, ":"); i >= 0 && !strings.Contains(authority[i:], "]") {
+		host, port = authority[:i], authority[i+1:]
+	}
+
+	// The zone of an IPv6 address is case-sensitive, so only the address is
+	// lowercased.
+	zone := ""
+	if i := strings.Index(host, "%25"); i >= 0 && strings.HasPrefix(host, "[") {
+		host, zone = host[:i], host[i:]
+	}
+	host = normalizePercentEncoding(strings.ToLower(host) + zone)
+	if _, isDefault := effectivePort(scheme, port); port != "" && isDefault {
+		port = ""
+	}
+
+	if port == "" {
+		return userinfo + host
+	}
+
+	return userinfo + host + ":" + port
+}
+
+// normalizePercentEncoding uppercases the hexadecimal digits of every
+// percent-encoding and decodes the ones that encode unreserved characters.
+// https://www.rfc-editor.org/rfc/rfc3986#section-6.2.2.2
+func normalizePercentEncoding(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' || i+2 >= len(s) || !isHexDigit(s[i+1]) || !isHexDigit(s[i+2]) {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		c := unhex(s[i+1])<<4 | unhex(s[i+2])
+		if isUnreserved(c) {
+			b.WriteByte(c)
+		} else {
+			percentEncodeByte(&b, c)
+		}
+		i += 2
+	}
+
+	return b.String()
+}
+
+// isUnreserved reports whether c is an unreserved character.
+// https://www.rfc-editor.org/rfc/rfc3986#section-2.3
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// removeDotSegments implements the remove_dot_segments algorithm.
+// https://www.rfc-editor.org/rfc/rfc3986#section-5.2.4
+func removeDotSegments(path string) string {
+	var output []string
+	input := path
+	for input != "" {
+		switch {
+		case strings.HasPrefix(input, "../"):
+			input = input[3:]
+		case strings.HasPrefix(input, "./"):
+			input = input[2:]
+		case strings.HasPrefix(input, "/./"):
+			input = input[2:]
+		case input == "/.":
+			input = "/"
+		case strings.HasPrefix(input, "/../"):
+			input = input[3:]
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "/..":
+			input = "/"
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "." || input == "..":
+			input = ""
+		default:
+			end := strings.IndexByte(input[1:], '/') + 1
+			if end == 0 {
+				end = len(input)
+			}
+			output = append(output, input[:end])
+			input = input[end:]
+		}
+	}
+
+	return strings.Join(output, "")
+}
+
+// sortQuery sorts the parameters of a raw query by name, keeping the order of
+// parameters with the same name. Empty parameters, like the one after the
+// trailing & of a=1&, are removed.
+func sortQuery(query string) string {
+	var params []string
+	for _, param := range strings.Split(query, "&") {
+		if param != "" {
+			params = append(params, param)
+		}
+	}
+
+	sort.SliceStable(params, func(i, j int) bool {
+		nameI, _, _ := strings.Cut(params[i], "=")
+		nameJ, _, _ := strings.Cut(params[j], "=")
+
+		return nameI < nameJ
+	})
+
+	return strings.Join(params, "&")
+}
+
+func isSpecialScheme(scheme string) bool {
+	_, ok := specialSchemes[scheme]
+
+	return ok
+}
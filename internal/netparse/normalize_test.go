@@ -0,0 +1,51 @@
+package netparse
+
+import "testing"
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  NormalizeURLOptions
+		want  string
+	}{
+		{"HTTP://User@Example.COM:80/a/./b/../c?%7ex=%2f#%41", NormalizeURLOptions{}, "http://User@example.com/a/c?~x=%2F#A"},
+		{"https://example.com", NormalizeURLOptions{}, "https://example.com/"},
+		{"https://example.com:8443/", NormalizeURLOptions{}, "https://example.com:8443/"},
+		{"http://[FE80::1%25Eth0]:8080/", NormalizeURLOptions{}, "http://[fe80::1%25Eth0]:8080/"},
+		{"http://[2001:DB8::1]/", NormalizeURLOptions{}, "http://[2001:db8::1]/"},
+		{"mailto:User@Example.com", NormalizeURLOptions{}, "mailto:User@Example.com"},
+		{"../a/./b", NormalizeURLOptions{}, "../a/./b"},
+		{"https://example.com/?b=2&a=1&b=1&a=0", NormalizeURLOptions{SortQuery: true}, "https://example.com/?a=1&a=0&b=2&b=1"},
+		{"https://example.com/?b=1&", NormalizeURLOptions{SortQuery: true}, "https://example.com/?b=1"},
+		{"https://example.com/?&&b=1&&a", NormalizeURLOptions{SortQuery: true}, "https://example.com/?a&b=1"},
+		{"https://example.com/?b=1&", NormalizeURLOptions{}, "https://example.com/?b=1&"},
+		{"https://example.com/#", NormalizeURLOptions{}, "https://example.com/#"},
+		{"https://example.com/#", NormalizeURLOptions{RemoveEmptyFragment: true}, "https://example.com/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeURL(tt.input, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeURLError(t *testing.T) {
+	for _, input := range []string{
+		"https://example.com/%zz",
+		"://example.com",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := NormalizeURL(input, NormalizeURLOptions{}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package netparse
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ObjectStorageProviderS3 is the provider of Amazon S3 URLs.
+	ObjectStorageProviderS3 = "s3"
+	// ObjectStorageProviderGCS is the provider of Google Cloud Storage URLs.
+	ObjectStorageProviderGCS = "gcs"
+	// ObjectStorageProviderAzure is the provider of Azure Blob Storage URLs.
+	ObjectStorageProviderAzure = "azure"
+)
+
+const (
+	// ObjectStorageStyleURI is the style of URLs like s3://bucket/key.
+	ObjectStorageStyleURI = "uri"
+	// ObjectStorageStyleVirtualHosted is the style of HTTPS URLs with the
+	// bucket in the host, like https://bucket.s3.amazonaws.com/key.
+	ObjectStorageStyleVirtualHosted = "virtual-hosted"
+	// ObjectStorageStylePath is the style of HTTPS URLs with the bucket in the
+	// path, like https://s3.amazonaws.com/bucket/key.
+	ObjectStorageStylePath = "path"
+)
+
+const (
+	defaultS3Endpoint    = "s3.amazonaws.com"
+	defaultGCSEndpoint   = "storage.googleapis.com"
+	defaultAzureEndpoint = "blob.core.windows.net"
+)
+
+var (
+	// s3HostPattern matches the S3 endpoints, with the bucket as the first
+	// group when the URL is virtual-hosted and the region as the third group.
+	// References used.
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/VirtualHosting.html
+	s3HostPattern = regexp.MustCompile(`^(?:(.+)\.)?(s3(?:\.dualstack)?(?:[.-]([a-z0-9-]+))?\.amazonaws\.com(?:\.cn)?)$`)
+	// gcsHostPattern matches the Cloud Storage endpoints, with the bucket as
+	// the first group when the URL is virtual-hosted.
+	// References used.
+	// https://cloud.google.com/storage/docs/request-endpoints
+	gcsHostPattern = regexp.MustCompile(`^(?:(.+)\.)?(storage\.googleapis\.com|storage\.cloud\.google\.com)$`)
+	// azureHostPattern matches the Blob Storage endpoints of the Azure clouds,
+	// with the account as the first group.
+	// References used.
+	// https://learn.microsoft.com/en-us/azure/storage/common/storage-account-overview#standard-endpoints
+	azureHostPattern = regexp.MustCompile(`^([a-z0-9]+)\.(blob\.core\.(?:windows\.net|chinacloudapi\.cn|usgovcloudapi\.net))$`)
+)
+
+// ObjectStorageURLModel describes the location of an object in an object
+// storage service.
+type ObjectStorageURLModel struct {
+	// Provider is the object storage service. It can be one of: s3, gcs or
+	// azure.
+	Provider string
+	// Style is the form of the URL. It can be one of: uri, virtual-hosted or
+	// path.
+	Style string
+	// Bucket is the bucket, or the container for Azure.
+	Bucket string
+	// Key is the key of the object, or the blob name for Azure. It's
+	// percent-decoded, except in the uri style, where it's written as is. It's
+	// empty when the URL points to the bucket.
+	Key string
+	// Region is the region of an S3 endpoint, when the host sets it.
+	Region string
+	// Account is the storage account of an Azure URL.
+	Account string
+	// Endpoint is the host of the service, without the bucket or the account,
+	// like s3.eu-west-1.amazonaws.com. It's empty for the uri style.
+	Endpoint string
+}
+
+// ParseObjectStorageURL parses an S3, Cloud Storage or Azure Blob Storage URL
+// and detects its provider and style. It accepts s3:// and gs:// URIs, and
+// HTTPS URLs in the virtual-hosted and path styles. The key of an s3:// or
+// gs:// URI is everything after the bucket, as the AWS and Google Cloud CLIs
+// read it, so it's not percent-decoded and can include ? and #. The query and
+// fragment of HTTPS URLs are ignored.
+func ParseObjectStorageURL(u string) (*ObjectStorageURLModel, error) {
+	if scheme, rest, ok := strings.Cut(u, "://"); ok && (strings.EqualFold(scheme, "s3") || strings.EqualFold(scheme, "gs")) {
+		model := &ObjectStorageURLModel{
+			Provider: ObjectStorageProviderS3,
+			Style:    ObjectStorageStyleURI,
+		}
+		if strings.EqualFold(scheme, "gs") {
+			model.Provider = ObjectStorageProviderGCS
+		}
+		model.Bucket, model.Key, _ = strings.Cut(rest, "/")
+
+		if model.Bucket == "" {
+			return nil, fmt.Errorf("URL %q has no bucket", u)
+		}
+
+		return model, nil
+	}
+
+	parsed, err := ParseURL(u)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := strings.ToLower(parsed.Scheme)
+	host := strings.ToLower(parsed.Host)
+	path := strings.TrimPrefix(parsed.Path, "/")
+	model := &ObjectStorageURLModel{}
+
+	switch {
+	case scheme != "https" && scheme != "http":
+		return nil, fmt.Errorf("unsupported object storage URL scheme %q", parsed.Scheme)
+	case s3HostPattern.MatchString(host):
+		match := s3HostPattern.FindStringSubmatch(host)
+		model.Provider = ObjectStorageProviderS3
+		model.Endpoint = match[2]
+		model.Region = match[3]
+		model.setBucketAndKey(match[1], path)
+	case gcsHostPattern.MatchString(host):
+		match := gcsHostPattern.FindStringSubmatch(host)
+		model.Provider = ObjectStorageProviderGCS
+		model.Endpoint = match[2]
+		model.setBucketAndKey(match[1], path)
+	case azureHostPattern.MatchString(host):
+		match := azureHostPattern.FindStringSubmatch(host)
+		model.Provider = ObjectStorageProviderAzure
+		model.Style = ObjectStorageStylePath
+		model.Account = match[1]
+		model.Endpoint = match[2]
+		model.Bucket, model.Key, _ = strings.Cut(path, "/")
+	default:
+		return nil, fmt.Errorf("URL %q is not an S3, Cloud Storage or Azure Blob Storage URL", u)
+	}
+
+	if model.Bucket == "" {
+		return nil, fmt.Errorf("URL %q has no bucket", u)
+	}
+
+	return model, nil
+}
+
+// setBucketAndKey sets the style, bucket and key from the bucket of the host,
+// which is empty for the path style, and the path.
+func (m *ObjectStorageURLModel) setBucketAndKey(hostBucket string, path string) {
+	if hostBucket != "" {
+		m.Style = ObjectStorageStyleVirtualHosted
+		m.Bucket = hostBucket
+		m.Key = path
+		return
+	}
+
+	m.Style = ObjectStorageStylePath
+	m.Bucket, m.Key, _ = strings.Cut(path, "/")
+}
+
+// BuildObjectStorageURL renders the location of an object in the given style.
+// The key is written as is in the uri style, and percent-encoded in the other
+// styles, so ParseObjectStorageURL returns the same key. An empty style
+// defaults to uri, or to path for Azure, which has no other style. When the
+// endpoint is empty, it's derived from the region for S3, and is the public
+// endpoint of the service otherwise.
+func BuildObjectStorageURL(m *ObjectStorageURLModel) (string, error) {
+	if m.Bucket == "" {
+		return "", fmt.Errorf("bucket is required")
+	}
+
+	style := m.Style
+	if style == "" {
+		style = ObjectStorageStyleURI
+		if m.Provider == ObjectStorageProviderAzure {
+			style = ObjectStorageStylePath
+		}
+	}
+
+	endpoint := m.Endpoint
+	var uriScheme string
+	switch m.Provider {
+	case ObjectStorageProviderS3:
+		uriScheme = "s3"
+		if endpoint == "" {
+			endpoint = defaultS3Endpoint
+			if m.Region != "" {
+				endpoint = "s3." + m.Region + ".amazonaws.com"
+			}
+		}
+	case ObjectStorageProviderGCS:
+		uriScheme = "gs"
+		if endpoint == "" {
+			endpoint = defaultGCSEndpoint
+		}
+	case ObjectStorageProviderAzure:
+		if style != ObjectStorageStylePath {
+			return "", fmt.Errorf("unsupported style %q for provider %q, expected %s", style, m.Provider, ObjectStorageStylePath)
+		}
+		if m.Account == "" {
+			return "", fmt.Errorf("account is required for provider %q", m.Provider)
+		}
+		if endpoint == "" {
+			endpoint = defaultAzureEndpoint
+		}
+		endpoint = m.Account + "." + endpoint
+	default:
+		return "", fmt.Errorf("unsupported provider %q, expected one of: %s, %s, %s", m.Provider, ObjectStorageProviderS3, ObjectStorageProviderGCS, ObjectStorageProviderAzure)
+	}
+
+	switch style {
+	case ObjectStorageStyleURI:
+		return uriScheme + "://" + m.Bucket + "/" + m.Key, nil
+	case ObjectStorageStyleVirtualHosted:
+		return (&url.URL{Scheme: "https", Host: m.Bucket + "." + endpoint, Path: "/" + m.Key}).String(), nil
+	case ObjectStorageStylePath:
+		return (&url.URL{Scheme: "https", Host: endpoint, Path: "/" + m.Bucket + "/" + m.Key}).String(), nil
+	default:
+		return "", fmt.Errorf("unsupported style %q, expected one of: %s, %s, %s", style, ObjectStorageStyleURI, ObjectStorageStyleVirtualHosted, ObjectStorageStylePath)
+	}
+}
@@ -0,0 +1,262 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseObjectStorageURL(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ObjectStorageURLModel
+	}{
+		{
+			input: "s3://my-bucket/path/to/object.csv",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStyleURI,
+				Bucket:   "my-bucket",
+				Key:      "path/to/object.csv",
+			},
+		},
+		{
+			input: "s3://my-bucket/reports/a?b#c.csv",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStyleURI,
+				Bucket:   "my-bucket",
+				Key:      "reports/a?b#c.csv",
+			},
+		},
+		{
+			input: "GS://my-bucket/a%20b",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderGCS,
+				Style:    ObjectStorageStyleURI,
+				Bucket:   "my-bucket",
+				Key:      "a%20b",
+			},
+		},
+		{
+			input: "https://my.bucket.s3.eu-west-1.amazonaws.com/path/to/a%20b.csv",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStyleVirtualHosted,
+				Bucket:   "my.bucket",
+				Key:      "path/to/a b.csv",
+				Region:   "eu-west-1",
+				Endpoint: "s3.eu-west-1.amazonaws.com",
+			},
+		},
+		{
+			input: "https://my-bucket.s3.amazonaws.com/object",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStyleVirtualHosted,
+				Bucket:   "my-bucket",
+				Key:      "object",
+				Endpoint: "s3.amazonaws.com",
+			},
+		},
+		{
+			input: "https://s3-us-west-2.amazonaws.com/my-bucket/object?versionId=1",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStylePath,
+				Bucket:   "my-bucket",
+				Key:      "object",
+				Region:   "us-west-2",
+				Endpoint: "s3-us-west-2.amazonaws.com",
+			},
+		},
+		{
+			input: "https://s3.dualstack.cn-north-1.amazonaws.com.cn/my-bucket",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderS3,
+				Style:    ObjectStorageStylePath,
+				Bucket:   "my-bucket",
+				Region:   "cn-north-1",
+				Endpoint: "s3.dualstack.cn-north-1.amazonaws.com.cn",
+			},
+		},
+		{
+			input: "gs://my-bucket/data/",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderGCS,
+				Style:    ObjectStorageStyleURI,
+				Bucket:   "my-bucket",
+				Key:      "data/",
+			},
+		},
+		{
+			input: "https://storage.googleapis.com/my-bucket/data/file.json",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderGCS,
+				Style:    ObjectStorageStylePath,
+				Bucket:   "my-bucket",
+				Key:      "data/file.json",
+				Endpoint: "storage.googleapis.com",
+			},
+		},
+		{
+			input: "https://my-bucket.storage.googleapis.com/file.json",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderGCS,
+				Style:    ObjectStorageStyleVirtualHosted,
+				Bucket:   "my-bucket",
+				Key:      "file.json",
+				Endpoint: "storage.googleapis.com",
+			},
+		},
+		{
+			input: "https://myaccount.blob.core.windows.net/mycontainer/dir/blob.txt",
+			want: ObjectStorageURLModel{
+				Provider: ObjectStorageProviderAzure,
+				Style:    ObjectStorageStylePath,
+				Bucket:   "mycontainer",
+				Key:      "dir/blob.txt",
+				Account:  "myaccount",
+				Endpoint: "blob.core.windows.net",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseObjectStorageURL(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseObjectStorageURLError(t *testing.T) {
+	for _, input := range []string{
+		"https://example.com/bucket/key",
+		"ftp://my-bucket.s3.amazonaws.com/key",
+		"https://s3.amazonaws.com/",
+		"https://myaccount.blob.core.windows.net",
+		"s3:///key",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseObjectStorageURL(input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestBuildObjectStorageURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		input ObjectStorageURLModel
+		want  string
+	}{
+		{
+			name:  "s3 uri",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderS3, Bucket: "my-bucket", Key: "a b.csv"},
+			want:  "s3://my-bucket/a b.csv",
+		},
+		{
+			name:  "s3 virtual-hosted with region",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderS3, Style: ObjectStorageStyleVirtualHosted, Bucket: "my-bucket", Key: "a b.csv", Region: "eu-west-1"},
+			want:  "https://my-bucket.s3.eu-west-1.amazonaws.com/a%20b.csv",
+		},
+		{
+			name:  "s3 path with endpoint",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderS3, Style: ObjectStorageStylePath, Bucket: "my-bucket", Key: "key", Region: "eu-west-1", Endpoint: "minio.example.com:9000"},
+			want:  "https://minio.example.com:9000/my-bucket/key",
+		},
+		{
+			name:  "gcs virtual-hosted",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderGCS, Style: ObjectStorageStyleVirtualHosted, Bucket: "my-bucket", Key: "file.json"},
+			want:  "https://my-bucket.storage.googleapis.com/file.json",
+		},
+		{
+			name:  "gcs uri of bucket",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderGCS, Style: ObjectStorageStyleURI, Bucket: "my-bucket"},
+			want:  "gs://my-bucket/",
+		},
+		{
+			name:  "azure",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderAzure, Bucket: "mycontainer", Key: "dir/blob.txt", Account: "myaccount"},
+			want:  "https://myaccount.blob.core.windows.net/mycontainer/dir/blob.txt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildObjectStorageURL(&tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildObjectStorageURLRoundTrip(t *testing.T) {
+	for _, style := range []string{ObjectStorageStyleURI, ObjectStorageStyleVirtualHosted, ObjectStorageStylePath} {
+		for _, key := range []string{"my file#1.txt", "a?b=c", "100%/x%20y", "dir/"} {
+			t.Run(style+" "+key, func(t *testing.T) {
+				u, err := BuildObjectStorageURL(&ObjectStorageURLModel{Provider: ObjectStorageProviderS3, Style: style, Bucket: "my-bucket", Key: key})
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				got, err := ParseObjectStorageURL(u)
+				if err != nil {
+					t.Fatalf("unexpected error parsing %q: %v", u, err)
+				}
+
+				if got.Key != key {
+					t.Errorf("got key %q from %q, want %q", got.Key, u, key)
+				}
+			})
+		}
+	}
+}
+
+func TestBuildObjectStorageURLError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input ObjectStorageURLModel
+	}{
+		{
+			name:  "missing bucket",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderS3},
+		},
+		{
+			name:  "unsupported provider",
+			input: ObjectStorageURLModel{Provider: "r2", Bucket: "b"},
+		},
+		{
+			name:  "azure uri",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderAzure, Style: ObjectStorageStyleURI, Bucket: "c", Account: "a"},
+		},
+		{
+			name:  "azure without account",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderAzure, Bucket: "c"},
+		},
+		{
+			name:  "unsupported style",
+			input: ObjectStorageURLModel{Provider: ObjectStorageProviderS3, Style: "website", Bucket: "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BuildObjectStorageURL(&tt.input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
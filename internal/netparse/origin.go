@@ -0,0 +1,135 @@
+package netparse
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// tupleOriginSchemes are the schemes whose URLs have a tuple origin. The
+// origin of the URLs with other schemes is opaque.
+// References used.
+// https://url.spec.whatwg.org/#concept-url-origin
+var tupleOriginSchemes = []string{
+	"ftp",
+	"http",
+	"https",
+	"ws",
+	"wss",
+}
+
+// SameOrigin reports whether the URLs have the same origin, that is, the same
+// scheme, host and port. The URLs are parsed following the WHATWG URL
+// Standard, and URLs with an opaque origin are never same origin.
+// References used.
+// https://html.spec.whatwg.org/multipage/browsers.html#same-origin
+func SameOrigin(a string, b string) (bool, error) {
+	urlA, err := ParseWHATWGURL(a)
+	if err != nil {
+		return false, err
+	}
+
+	urlB, err := ParseWHATWGURL(b)
+	if err != nil {
+		return false, err
+	}
+
+	return urlA.Origin != "" && urlA.Origin == urlB.Origin, nil
+}
+
+// SameSite reports whether the URLs have the same site, that is, the same
+// registrable domain, or the same host when it has no registrable domain, like
+// an IP address or localhost. When schemeful is true, the schemes must also be
+// equal. The URLs are parsed following the WHATWG URL Standard, and URLs with
+// an opaque origin are never same site.
+// References used.
+// https://html.spec.whatwg.org/multipage/browsers.html#same-site
+// https://html.spec.whatwg.org/multipage/browsers.html#schemelessly-same-site
+func SameSite(a string, b string, schemeful bool) (bool, error) {
+	urlA, err := ParseWHATWGURL(a)
+	if err != nil {
+		return false, err
+	}
+
+	urlB, err := ParseWHATWGURL(b)
+	if err != nil {
+		return false, err
+	}
+
+	if urlA.Origin == "" || urlB.Origin == "" {
+		return false, nil
+	}
+
+	siteA, siteB := originSite(urlA), originSite(urlB)
+	if !schemeful {
+		siteA.scheme, siteB.scheme = "", ""
+	}
+
+	return siteA == siteB, nil
+}
+
+// site is the scheme and host, or registrable domain, of a tuple origin.
+// https://html.spec.whatwg.org/multipage/browsers.html#obtain-a-site
+type site struct {
+	scheme string
+	host   string
+}
+
+// originSite returns the site of the origin of the URL. The origin is parsed
+// again because the origin of a blob URL is the origin of the URL in its path.
+func originSite(u *URLModel) site {
+	origin, err := ParseWHATWGURL(u.Origin)
+	if err != nil {
+		return site{}
+	}
+
+	if origin.HostType == HostTypeDomain && origin.Domain != nil {
+		return site{scheme: origin.Scheme, host: origin.Domain.Domain}
+	}
+
+	return site{scheme: origin.Scheme, host: origin.Host}
+}
+
+// renderOrigin returns the ASCII serialization of the origin of a URL parsed
+// with net/url, or an empty string when the origin is opaque. The origin is
+// parsed again following the WHATWG URL Standard, so it's the same as in
+// URLModeWHATWG: the scheme and host are lowercased, the host is converted to
+// ASCII, the IPv6 zone is dropped and the default port is omitted.
+func renderOrigin(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+
+	if scheme == "blob" {
+		pathURL, err := url.Parse(u.Opaque)
+		if err != nil || u.Opaque == "" {
+			return ""
+		}
+
+		if pathScheme := strings.ToLower(pathURL.Scheme); pathScheme == "http" || pathScheme == "https" {
+			return renderOrigin(pathURL)
+		}
+
+		return ""
+	}
+
+	if !slices.Contains(tupleOriginSchemes, scheme) || u.Hostname() == "" {
+		return ""
+	}
+
+	host := u.Hostname()
+	if strings.Contains(host, ":") {
+		host, _, _ = strings.Cut(host, "%")
+		host = "[" + host + "]"
+	}
+
+	origin := scheme + "://" + host
+	if port := u.Port(); port != "" {
+		origin += ":" + port
+	}
+
+	originURL, err := parseWHATWG(origin, nil)
+	if err != nil {
+		return ""
+	}
+
+	return originURL.origin()
+}
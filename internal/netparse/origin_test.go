@@ -0,0 +1,127 @@
+package netparse
+
+import "testing"
+
+func TestParseURLOrigin(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"https://example.com/path?q=1#frag", "https://example.com"},
+		{"HTTPS://User@EXAMPLE.com:443/", "https://example.com"},
+		{"http://example.com:8080/", "http://example.com:8080"},
+		{"wss://[2001:DB8::1]:8443/socket", "wss://[2001:db8::1]:8443"},
+		{"blob:https://example.com:8443/uuid", "https://example.com:8443"},
+		{"blob:file:///uuid", ""},
+		{"file:///etc/hosts", ""},
+		{"data:text/plain,hello", ""},
+		{"postgres://db.example.com/app", ""},
+		{"https://bücher.example/", "https://xn--bcher-kva.example"},
+		{"https://B%C3%BCcher.Example:0443/", "https://xn--bcher-kva.example"},
+		{"http://127.1:8080/", "http://127.0.0.1:8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			for _, mode := range []string{URLModeRFC3986, URLModeWHATWG} {
+				got, err := ParseURLWithMode(tt.input, mode)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if got.Origin != tt.want {
+					t.Errorf("got %s origin %q, want %q", mode, got.Origin, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseURLOriginZone(t *testing.T) {
+	got, err := ParseURLWithMode("http://[fe80::1%25Eth0]:8080/", URLModeRFC3986)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "http://[fe80::1]:8080"; got.Origin != want {
+		t.Errorf("got origin %q, want %q", got.Origin, want)
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"https://example.com/a", "https://EXAMPLE.com:443/b?q=1", true},
+		{"https://example.com", "http://example.com", false},
+		{"https://example.com", "https://www.example.com", false},
+		{"https://example.com", "https://example.com:8443", false},
+		{"https://example.com", "blob:https://example.com/uuid", true},
+		{"file:///a", "file:///a", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			got, err := SameOrigin(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameSite(t *testing.T) {
+	tests := []struct {
+		a         string
+		b         string
+		schemeful bool
+		want      bool
+	}{
+		{"https://www.example.com", "https://api.example.com:8443", true, true},
+		{"https://app.example.co.uk", "https://example.co.uk", true, true},
+		{"https://a.example.co.uk", "https://b.other.co.uk", true, false},
+		{"https://www.example.com", "http://www.example.com", true, false},
+		{"https://www.example.com", "http://api.example.com", false, true},
+		{"https://alice.github.io", "https://bob.github.io", true, false},
+		{"http://127.0.0.1:8080", "http://127.0.0.1:9090", true, true},
+		{"http://10.0.0.1", "http://10.0.0.2", true, false},
+		{"http://localhost:3000", "http://localhost:8080", true, true},
+		{"data:text/plain,a", "data:text/plain,a", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			got, err := SameSite(tt.a, tt.b, tt.schemeful)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameSiteError(t *testing.T) {
+	for _, input := range []string{
+		"example.com",
+		"https://exa mple.com",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := SameSite(input, "https://example.com", true); err == nil {
+				t.Error("expected error")
+			}
+
+			if _, err := SameOrigin("https://example.com", input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
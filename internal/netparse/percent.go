@@ -0,0 +1,111 @@
+package netparse
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// percentEncodeSet reports whether a code point must be percent-encoded.
+// References used.
+// https://url.spec.whatwg.org/#percent-encoded-bytes
+type percentEncodeSet func(r rune) bool
+
+func inC0ControlPercentEncodeSet(r rune) bool {
+	return r < 0x20 || r > 0x7E
+}
+
+func inFragmentPercentEncodeSet(r rune) bool {
+	return inC0ControlPercentEncodeSet(r) || strings.ContainsRune(" \"<>`", r)
+}
+
+func inQueryPercentEncodeSet(r rune) bool {
+	return inC0ControlPercentEncodeSet(r) || strings.ContainsRune(" \"#<>", r)
+}
+
+func inSpecialQueryPercentEncodeSet(r rune) bool {
+	return inQueryPercentEncodeSet(r) || r == '\''
+}
+
+func inPathPercentEncodeSet(r rune) bool {
+	return inQueryPercentEncodeSet(r) || strings.ContainsRune("?`{}", r)
+}
+
+func inUserinfoPercentEncodeSet(r rune) bool {
+	return inPathPercentEncodeSet(r) || strings.ContainsRune("/:;=@[\\]^|", r)
+}
+
+func inComponentPercentEncodeSet(r rune) bool {
+	return inUserinfoPercentEncodeSet(r) || strings.ContainsRune("$%&+,", r)
+}
+
+func inFormURLEncodedPercentEncodeSet(r rune) bool {
+	return inComponentPercentEncodeSet(r) || strings.ContainsRune("!'()~", r)
+}
+
+// percentEncodeRune appends the UTF-8 percent-encoding of r to b when r is in
+// set, and r itself otherwise.
+func percentEncodeRune(b *strings.Builder, r rune, set percentEncodeSet) {
+	if !set(r) {
+		b.WriteRune(r)
+		return
+	}
+
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	for _, c := range buf[:n] {
+		percentEncodeByte(b, c)
+	}
+}
+
+func percentEncodeByte(b *strings.Builder, c byte) {
+	const upperHex = "0123456789ABCDEF"
+
+	b.WriteByte('%')
+	b.WriteByte(upperHex[c>>4])
+	b.WriteByte(upperHex[c&0x0F])
+}
+
+// percentEncodeString applies percentEncodeRune to every code point of s.
+func percentEncodeString(s string, set percentEncodeSet) string {
+	var b strings.Builder
+	for _, r := range s {
+		percentEncodeRune(&b, r, set)
+	}
+
+	return b.String()
+}
+
+// percentDecode decodes every valid percent-encoded byte of s and leaves any
+// other byte, including malformed escapes, untouched.
+func percentDecode(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]) {
+			b = append(b, unhex(s[i+1])<<4|unhex(s[i+2]))
+			i += 2
+			continue
+		}
+		b = append(b, s[i])
+	}
+
+	return string(b)
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,86 @@
+package netparse
+
+import (
+	"sort"
+	"strings"
+)
+
+// QueryParam describes a name-value pair of a URL query.
+type QueryParam struct {
+	Name  string
+	Value string
+}
+
+// ParseQuery decodes a query string into a map from each parameter name to
+// its values, in the order they appear. A leading "?" is ignored.
+func ParseQuery(q string) map[string][]string {
+	params := make(map[string][]string)
+	for _, param := range ParseQueryParams(q) {
+		params[param.Name] = append(params[param.Name], param.Value)
+	}
+
+	return params
+}
+
+// ParseQueryParams decodes a query string into its list of name-value pairs
+// following the application/x-www-form-urlencoded parser. A leading "?" is
+// ignored.
+// References used.
+// https://url.spec.whatwg.org/#concept-urlencoded-parser
+func ParseQueryParams(q string) []QueryParam {
+	q = strings.TrimPrefix(q, "?")
+
+	var params []QueryParam
+	for _, sequence := range strings.Split(q, "&") {
+		if sequence == "" {
+			continue
+		}
+
+		name, value, _ := strings.Cut(sequence, "=")
+		params = append(params, QueryParam{
+			Name:  decodeFormURLEncoded(name),
+			Value: decodeFormURLEncoded(value),
+		})
+	}
+
+	return params
+}
+
+func decodeFormURLEncoded(s string) string {
+	s = percentDecode(strings.ReplaceAll(s, "+", " "))
+
+	return strings.ToValidUTF8(s, "�")
+}
+
+// EncodeQuery encodes query parameters following the
+// application/x-www-form-urlencoded serializer, sorted by name. Values of the
+// same name keep their order.
+// References used.
+// https://url.spec.whatwg.org/#concept-urlencoded-serializer
+func EncodeQuery(params map[string][]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		for _, value := range params[name] {
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(encodeFormURLEncoded(name))
+			b.WriteByte('=')
+			b.WriteString(encodeFormURLEncoded(value))
+		}
+	}
+
+	return b.String()
+}
+
+func encodeFormURLEncoded(s string) string {
+	return strings.ReplaceAll(percentEncodeString(s, func(r rune) bool {
+		return r != ' ' && inFormURLEncodedPercentEncodeSet(r)
+	}), " ", "+")
+}
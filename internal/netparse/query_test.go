@@ -0,0 +1,85 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseQueryParams(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []QueryParam
+	}{
+		{"", nil},
+		{"?", nil},
+		{"?a=1&b=2", []QueryParam{{"a", "1"}, {"b", "2"}}},
+		{"q=a+b%2Bc", []QueryParam{{"q", "a b+c"}}},
+		{"a+b=c+d", []QueryParam{{"a b", "c d"}}},
+		{"x=%zz&y=%4", []QueryParam{{"x", "%zz"}, {"y", "%4"}}},
+		{"x=%FF", []QueryParam{{"x", "\ufffd"}}},
+		{"=1&a&b=", []QueryParam{{"", "1"}, {"a", ""}, {"b", ""}}},
+		{"&&a=1&&", []QueryParam{{"a", "1"}}},
+		{"a=1=2", []QueryParam{{"a", "1=2"}}},
+		{"a=1&b=2&a=3", []QueryParam{{"a", "1"}, {"b", "2"}, {"a", "3"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ParseQueryParams(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		want  map[string][]string
+	}{
+		{"", map[string][]string{}},
+		{"?a=1&b=2&a=3", map[string][]string{"a": {"1", "3"}, "b": {"2"}}},
+		{"q=a+b&q=%zz", map[string][]string{"q": {"a b", "%zz"}}},
+		{"=1&=2&c", map[string][]string{"": {"1", "2"}, "c": {""}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ParseQuery(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		input map[string][]string
+		want  string
+	}{
+		{"empty", map[string][]string{}, ""},
+		{"sorted", map[string][]string{"b": {"2"}, "a": {"1"}}, "a=1&b=2"},
+		{"repeated", map[string][]string{"a": {"3", "1"}, "b": {"2"}}, "a=3&a=1&b=2"},
+		{"space and plus", map[string][]string{"q": {"a b+c"}}, "q=a+b%2Bc"},
+		{"percent", map[string][]string{"x": {"%zz"}}, "x=%25zz"},
+		{"empty name and value", map[string][]string{"": {"1"}, "a": {""}}, "=1&a="},
+		{"reserved", map[string][]string{"a&b": {"c=d/é"}}, "a%26b=c%3Dd%2F%C3%A9"},
+		{"no values", map[string][]string{"a": {}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodeQuery(tt.input); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryRoundTrip(t *testing.T) {
+	params := map[string][]string{"a b": {"1+1", "%zz"}, "": {"x"}, "é": {"&="}}
+	if got := ParseQuery(EncodeQuery(params)); !reflect.DeepEqual(got, params) {
+		t.Errorf("got %v, want %v", got, params)
+	}
+}
@@ -0,0 +1,99 @@
+package netparse
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// DefaultRedactionMask replaces the redacted components when no mask is set.
+// It's the mask used by the Redacted method of the net/url go package.
+const DefaultRedactionMask = "xxxxx"
+
+// RedactURLOptions configures which components RedactURL replaces with the
+// mask. The password is always redacted.
+type RedactURLOptions struct {
+	// Mask replaces each redacted component. It's inserted as is. Defaults to
+	// DefaultRedactionMask.
+	Mask string
+	// RedactUsername also redacts the username.
+	RedactUsername bool
+	// QueryParams are the names of the query parameters whose values are
+	// redacted. Names are matched case-insensitively after decoding.
+	QueryParams []string
+}
+
+// RedactURL replaces the password of the URL, and optionally the username and
+// the values of some query parameters, with a mask. The rest of the URL is
+// kept byte for byte.
+func RedactURL(u string, opts RedactURLOptions) (string, error) {
+	if _, err := url.Parse(u); err != nil {
+		return "", err
+	}
+
+	mask := opts.Mask
+	if mask == "" {
+		mask = DefaultRedactionMask
+	}
+
+	match := uriReferencePattern.FindStringSubmatch(u)
+
+	var b strings.Builder
+	b.WriteString(match[1])
+
+	if match[3] != "" {
+		b.WriteString("//" + redactAuthority(match[4], mask, opts.RedactUsername))
+	}
+
+	b.WriteString(match[5])
+
+	if match[6] != "" {
+		b.WriteString("?" + redactQuery(match[7], mask, opts.QueryParams))
+	}
+
+	b.WriteString(match[8])
+
+	return b.String(), nil
+}
+
+func redactAuthority(authority string, mask string, redactUsername bool) string {
+	i := strings.LastIndex(authority, "@")
+	if i < 0 {
+		return authority
+	}
+
+	username, password, hasPassword := strings.Cut(authority[:i], ":")
+	if redactUsername && username != "" {
+		username = mask
+	}
+	if password != "" {
+		password = mask
+	}
+
+	if hasPassword {
+		return username + ":" + password + authority[i:]
+	}
+
+	return username + authority[i:]
+}
+
+func redactQuery(query string, mask string, names []string) string {
+	if len(names) == 0 {
+		return query
+	}
+
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		name, value, hasValue := strings.Cut(param, "=")
+		if !hasValue || value == "" {
+			continue
+		}
+
+		decoded := decodeFormURLEncoded(name)
+		if slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, decoded) }) {
+			params[i] = name + "=" + mask
+		}
+	}
+
+	return strings.Join(params, "&")
+}
@@ -0,0 +1,139 @@
+package netparse
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// schemeDefaultPorts maps each known scheme to the port used when a URL with
+// that scheme doesn't set one.
+// References used.
+// https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml
+// https://www.iana.org/assignments/service-names-port-numbers/service-names-port-numbers.xhtml
+var schemeDefaultPorts = map[string]int{
+	"amqp":       5672,
+	"amqps":      5671,
+	"cassandra":  9042,
+	"coap":       5683,
+	"coaps":      5684,
+	"ftp":        21,
+	"ftps":       990,
+	"git":        9418,
+	"gopher":     70,
+	"http":       80,
+	"https":      443,
+	"imap":       143,
+	"imaps":      993,
+	"irc":        6667,
+	"ircs":       6697,
+	"kafka":      9092,
+	"ldap":       389,
+	"ldaps":      636,
+	"mariadb":    3306,
+	"memcached":  11211,
+	"mongodb":    27017,
+	"mqtt":       1883,
+	"mqtts":      8883,
+	"mssql":      1433,
+	"mysql":      3306,
+	"nats":       4222,
+	"nntp":       119,
+	"pop3":       110,
+	"pop3s":      995,
+	"postgres":   5432,
+	"postgresql": 5432,
+	"rdp":        3389,
+	"redis":      6379,
+	"rediss":     6379,
+	"rtsp":       554,
+	"sftp":       22,
+	"sip":        5060,
+	"sips":       5061,
+	"smtp":       25,
+	"smtps":      465,
+	"sqlserver":  1433,
+	"ssh":        22,
+	"telnet":     23,
+	"vnc":        5900,
+	"ws":         80,
+	"wss":        443,
+	"xmpp":       5222,
+	"zookeeper":  2181,
+}
+
+// registeredSchemes are the registered schemes, besides the ones in
+// schemeDefaultPorts, that are common in URLs without an authority.
+// References used.
+// https://www.iana.org/assignments/uri-schemes/uri-schemes.xhtml
+var registeredSchemes = []string{
+	"about",
+	"blob",
+	"data",
+	"file",
+	"geo",
+	"javascript",
+	"magnet",
+	"mailto",
+	"news",
+	"sms",
+	"tel",
+	"urn",
+}
+
+// isRegisteredScheme reports whether the scheme is a registered scheme known to
+// this package.
+func isRegisteredScheme(scheme string) bool {
+	scheme = strings.ToLower(scheme)
+	_, hasDefaultPort := schemeDefaultPorts[scheme]
+
+	return hasDefaultPort || slices.Contains(registeredSchemes, scheme)
+}
+
+// tlsSchemes are the schemes whose connections are always secured with TLS.
+var tlsSchemes = []string{
+	"amqps",
+	"coaps",
+	"ftps",
+	"https",
+	"imaps",
+	"ircs",
+	"ldaps",
+	"mqtts",
+	"pop3s",
+	"rediss",
+	"sips",
+	"smtps",
+	"wss",
+}
+
+// IsTLSScheme reports whether connections to URLs with the scheme are always
+// secured with TLS, like https or wss.
+func IsTLSScheme(scheme string) bool {
+	return slices.Contains(tlsSchemes, strings.ToLower(scheme))
+}
+
+// DefaultPort returns the default port of the scheme. The second result is
+// false when the scheme is not known.
+func DefaultPort(scheme string) (int, bool) {
+	port, ok := schemeDefaultPorts[strings.ToLower(scheme)]
+
+	return port, ok
+}
+
+// effectivePort returns the port that a URL with the given scheme and port
+// connects to, and whether it's the default port of the scheme. The port is 0
+// when the URL doesn't set one and the scheme has no default port.
+func effectivePort(scheme string, port string) (int, bool) {
+	defaultPort, known := DefaultPort(scheme)
+	if port == "" {
+		return defaultPort, known
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return 0, false
+	}
+
+	return p, known && p == defaultPort
+}
@@ -0,0 +1,44 @@
+package netparse
+
+import "testing"
+
+func TestParseURLEffectivePort(t *testing.T) {
+	tests := []struct {
+		input            string
+		effectivePort    int
+		hasEffectivePort bool
+		isDefaultPort    bool
+	}{
+		{"https://api.example.com", 443, true, true},
+		{"https://api.example.com:443", 443, true, true},
+		{"https://api.example.com:8443", 8443, true, false},
+		{"HTTP://example.com/", 80, true, true},
+		{"postgres://user@db.example.com/app", 5432, true, true},
+		{"redis://cache.example.com:6380", 6380, true, false},
+		{"ssh://git@example.com", 22, true, true},
+		{"unknown://example.com", 0, false, false},
+		{"unknown://example.com:1234", 1234, true, false},
+		{"unknown://example.com:0", 0, true, false},
+		{"https://example.com:0", 0, true, false},
+		{"mailto:user@example.com", 0, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseURL(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.EffectivePort != tt.effectivePort {
+				t.Errorf("effective port: got %d, want %d", got.EffectivePort, tt.effectivePort)
+			}
+			if got.HasEffectivePort != tt.hasEffectivePort {
+				t.Errorf("has effective port: got %t, want %t", got.HasEffectivePort, tt.hasEffectivePort)
+			}
+			if got.IsDefaultPort != tt.isDefaultPort {
+				t.Errorf("is default port: got %t, want %t", got.IsDefaultPort, tt.isDefaultPort)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package netparse
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// uriTemplateOperator describes how an expression operator expands its
+// variables.
+// https://www.rfc-editor.org/rfc/rfc6570#appendix-A
+type uriTemplateOperator struct {
+	first string
+	sep   string
+	named bool
+	// ifEmpty follows the name of a named variable whose value is empty.
+	ifEmpty string
+	// allowReserved keeps reserved characters and percent-encodings.
+	allowReserved bool
+}
+
+var uriTemplateOperators = map[byte]uriTemplateOperator{
+	'+': {first: "", sep: ",", allowReserved: true},
+	'#': {first: "#", sep: ",", allowReserved: true},
+	'.': {first: ".", sep: "."},
+	'/': {first: "/", sep: "/"},
+	';': {first: ";", sep: ";", named: true},
+	'?': {first: "?", sep: "&", named: true, ifEmpty: "="},
+	'&': {first: "&", sep: "&", named: true, ifEmpty: "="},
+}
+
+// ExpandURITemplate expands a URI template with the given variables, following
+// all the levels of RFC 6570. The value of a variable can be a string, a
+// []string list or a map[string]string associative array, whose keys are
+// expanded in lexical order. Variables that are missing, nil, or empty lists
+// or maps are undefined.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc6570
+func ExpandURITemplate(template string, vars map[string]any) (string, error) {
+	var b strings.Builder
+
+	for template != "" {
+		start := strings.IndexAny(template, "{}")
+		if start < 0 {
+			writeURITemplateLiteral(&b, template)
+			break
+		}
+		if template[start] == '}' {
+			return "", fmt.Errorf("unexpected \"}\" at %q", template[start:])
+		}
+
+		writeURITemplateLiteral(&b, template[:start])
+
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unclosed expression %q", template[start:])
+		}
+		end += start
+
+		if err := expandURITemplateExpression(&b, template[start+1:end], vars); err != nil {
+			return "", err
+		}
+		template = template[end+1:]
+	}
+
+	return b.String(), nil
+}
+
+func expandURITemplateExpression(b *strings.Builder, expression string, vars map[string]any) error {
+	op := uriTemplateOperator{sep: ","}
+	if expression != "" {
+		if o, ok := uriTemplateOperators[expression[0]]; ok {
+			op, expression = o, expression[1:]
+		} else if strings.ContainsRune("=,!@|", rune(expression[0])) {
+			return fmt.Errorf("reserved operator %q in expression {%s}", expression[0], expression)
+		}
+	}
+
+	first := true
+	for _, spec := range strings.Split(expression, ",") {
+		name, explode, prefix, err := parseURITemplateVarSpec(spec)
+		if err != nil {
+			return err
+		}
+
+		var expanded string
+		var defined bool
+		switch value := vars[name].(type) {
+		case nil:
+		case string:
+			if prefix >= 0 && utf8.RuneCountInString(value) > prefix {
+				value = string([]rune(value)[:prefix])
+			}
+			expanded, defined = expandURITemplateString(op, name, value), true
+		case []string:
+			if prefix >= 0 {
+				return fmt.Errorf("prefix modifier in %q applied to a list", spec)
+			}
+			expanded, defined = expandURITemplateList(op, name, value, explode), len(value) > 0
+		case map[string]string:
+			if prefix >= 0 {
+				return fmt.Errorf("prefix modifier in %q applied to an associative array", spec)
+			}
+			expanded, defined = expandURITemplateMap(op, name, value, explode), len(value) > 0
+		default:
+			return fmt.Errorf("unsupported value of type %T for variable %q", value, name)
+		}
+
+		if !defined {
+			continue
+		}
+
+		if first {
+			b.WriteString(op.first)
+			first = false
+		} else {
+			b.WriteString(op.sep)
+		}
+		b.WriteString(expanded)
+	}
+
+	return nil
+}
+
+// parseURITemplateVarSpec parses a variable name with an optional explode
+// modifier or prefix modifier. The prefix is -1 when not set.
+func parseURITemplateVarSpec(spec string) (string, bool, int, error) {
+	name, explode, prefix := spec, false, -1
+
+	if strings.HasSuffix(spec, "*") {
+		name, explode = spec[:len(spec)-1], true
+	} else if i := strings.IndexByte(spec, ':'); i >= 0 {
+		p, err := strconv.Atoi(spec[i+1:])
+		if err != nil || p <= 0 || p >= 10000 || spec[i+1] == '0' {
+			return "", false, 0, fmt.Errorf("invalid prefix modifier in %q", spec)
+		}
+		name, prefix = spec[:i], p
+	}
+
+	if !isURITemplateVarName(name) {
+		return "", false, 0, fmt.Errorf("invalid variable name %q", name)
+	}
+
+	return name, explode, prefix, nil
+}
+
+// isURITemplateVarName reports whether name is made of ALPHA, DIGIT, "_" and
+// percent-encodings, with single dots between them.
+func isURITemplateVarName(name string) bool {
+	if name == "" || strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") || strings.Contains(name, "..") {
+		return false
+	}
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(name) || !isHexDigit(name[i+1]) || !isHexDigit(name[i+2]) {
+				return false
+			}
+			i += 2
+		case !isASCIIAlphanumeric(rune(c)) && c != '_' && c != '.':
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandURITemplateString(op uriTemplateOperator, name string, value string) string {
+	if !op.named {
+		return encodeURITemplateValue(value, op.allowReserved)
+	}
+
+	if value == "" {
+		return name + op.ifEmpty
+	}
+
+	return name + "=" + encodeURITemplateValue(value, op.allowReserved)
+}
+
+func expandURITemplateList(op uriTemplateOperator, name string, values []string, explode bool) string {
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		if explode && op.named {
+			items = append(items, expandURITemplateString(op, name, value))
+		} else {
+			items = append(items, encodeURITemplateValue(value, op.allowReserved))
+		}
+	}
+
+	if explode {
+		return strings.Join(items, op.sep)
+	}
+
+	return joinURITemplateNamed(op, name, strings.Join(items, ","))
+}
+
+func expandURITemplateMap(op uriTemplateOperator, name string, values map[string]string, explode bool) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		encodedKey := encodeURITemplateValue(key, op.allowReserved)
+		encodedValue := encodeURITemplateValue(values[key], op.allowReserved)
+
+		switch {
+		case explode && values[key] == "" && op.named:
+			items = append(items, encodedKey+op.ifEmpty)
+		case explode:
+			items = append(items, encodedKey+"="+encodedValue)
+		default:
+			items = append(items, encodedKey+","+encodedValue)
+		}
+	}
+
+	if explode {
+		return strings.Join(items, op.sep)
+	}
+
+	return joinURITemplateNamed(op, name, strings.Join(items, ","))
+}
+
+func joinURITemplateNamed(op uriTemplateOperator, name string, value string) string {
+	if !op.named {
+		return value
+	}
+
+	if value == "" {
+		return name + op.ifEmpty
+	}
+
+	return name + "=" + value
+}
+
+// encodeURITemplateValue percent-encodes the characters of s that are not
+// unreserved. When allowReserved is true, reserved characters and
+// percent-encodings are kept.
+func encodeURITemplateValue(s string, allowReserved bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case isUnreserved(c):
+			b.WriteByte(c)
+		case allowReserved && isReserved(c):
+			b.WriteByte(c)
+		case allowReserved && c == '%' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]):
+			b.WriteString(s[i : i+3])
+			i += 2
+		default:
+			percentEncodeByte(&b, c)
+		}
+	}
+
+	return b.String()
+}
+
+// writeURITemplateLiteral writes the literal characters of a template,
+// percent-encoding the ones that are not allowed in a URI.
+func writeURITemplateLiteral(b *strings.Builder, literal string) {
+	b.WriteString(encodeURITemplateValue(literal, true))
+}
+
+// isReserved reports whether c is a reserved character.
+// https://www.rfc-editor.org/rfc/rfc3986#section-2.2
+func isReserved(c byte) bool {
+	return strings.IndexByte(":/?#[]@!$&'()*+,;=", c) >= 0
+}
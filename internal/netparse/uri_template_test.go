@@ -0,0 +1,179 @@
+package netparse
+
+import "testing"
+
+func TestExpandURITemplate(t *testing.T) {
+	// Variables and examples from https://www.rfc-editor.org/rfc/rfc6570#section-3.2
+	vars := map[string]any{
+		"count":      []string{"one", "two", "three"},
+		"dom":        []string{"example", "com"},
+		"dub":        "me/too",
+		"hello":      "Hello World!",
+		"half":       "50%",
+		"var":        "value",
+		"who":        "fred",
+		"base":       "http://example.com/home/",
+		"path":       "/foo/bar",
+		"list":       []string{"red", "green", "blue"},
+		"keys":       map[string]string{"semi": ";", "dot": ".", "comma": ","},
+		"v":          "6",
+		"x":          "1024",
+		"y":          "768",
+		"empty":      "",
+		"empty_keys": map[string]string{},
+		"undef":      nil,
+	}
+
+	tests := map[string]string{
+		// Level 1 and simple string expansion.
+		"{var}":        "value",
+		"{hello}":      "Hello%20World%21",
+		"{half}":       "50%25",
+		"O{empty}X":    "OX",
+		"O{undef}X":    "OX",
+		"{x,y}":        "1024,768",
+		"{x,hello,y}":  "1024,Hello%20World%21,768",
+		"?{x,empty}":   "?1024,",
+		"?{x,undef}":   "?1024",
+		"?{undef,y}":   "?768",
+		"{var:3}":      "val",
+		"{var:30}":     "value",
+		"{list}":       "red,green,blue",
+		"{list*}":      "red,green,blue",
+		"{keys}":       "comma,%2C,dot,.,semi,%3B",
+		"{keys*}":      "comma=%2C,dot=.,semi=%3B",
+		"{count}":      "one,two,three",
+		"{count*}":     "one,two,three",
+		"{/count}":     "/one,two,three",
+		"{/count*}":    "/one/two/three",
+		"{;count}":     ";count=one,two,three",
+		"{;count*}":    ";count=one;count=two;count=three",
+		"{?count}":     "?count=one,two,three",
+		"{?count*}":    "?count=one&count=two&count=three",
+		"{&count*}":    "&count=one&count=two&count=three",
+		"{dub}":        "me%2Ftoo",
+		"{base}index":  "http%3A%2F%2Fexample.com%2Fhome%2Findex",
+		"{+base}index": "http://example.com/home/index",
+		// Reserved expansion.
+		"{+var}":              "value",
+		"{+hello}":            "Hello%20World!",
+		"{+half}":             "50%25",
+		"O{+empty}X":          "OX",
+		"{+path}/here":        "/foo/bar/here",
+		"here?ref={+path}":    "here?ref=/foo/bar",
+		"up{+path}{var}/here": "up/foo/barvalue/here",
+		"{+x,hello,y}":        "1024,Hello%20World!,768",
+		"{+path,x}/here":      "/foo/bar,1024/here",
+		"{+path:6}/here":      "/foo/b/here",
+		"{+list}":             "red,green,blue",
+		"{+keys}":             "comma,,,dot,.,semi,;",
+		// Fragment expansion.
+		"{#var}":         "#value",
+		"{#hello}":       "#Hello%20World!",
+		"{#half}":        "#50%25",
+		"foo{#empty}":    "foo#",
+		"foo{#undef}":    "foo",
+		"{#x,hello,y}":   "#1024,Hello%20World!,768",
+		"{#path,x}/here": "#/foo/bar,1024/here",
+		"{#path:6}/here": "#/foo/b/here",
+		"{#list*}":       "#red,green,blue",
+		"{#keys*}":       "#comma=,,dot=.,semi=;",
+		// Label expansion.
+		"{.who}":          ".fred",
+		"{.who,who}":      ".fred.fred",
+		"{.half,who}":     ".50%25.fred",
+		"www{.dom*}":      "www.example.com",
+		"X{.var}":         "X.value",
+		"X{.empty}":       "X.",
+		"X{.undef}":       "X",
+		"X{.var:3}":       "X.val",
+		"X{.list}":        "X.red,green,blue",
+		"X{.list*}":       "X.red.green.blue",
+		"X{.keys*}":       "X.comma=%2C.dot=..semi=%3B",
+		"X{.empty_keys}":  "X",
+		"X{.empty_keys*}": "X",
+		// Path segment expansion.
+		"{/who}":          "/fred",
+		"{/who,who}":      "/fred/fred",
+		"{/half,who}":     "/50%25/fred",
+		"{/who,dub}":      "/fred/me%2Ftoo",
+		"{/var}":          "/value",
+		"{/var,empty}":    "/value/",
+		"{/var,undef}":    "/value",
+		"{/var,x}/here":   "/value/1024/here",
+		"{/var:1,var}":    "/v/value",
+		"{/list}":         "/red,green,blue",
+		"{/list*}":        "/red/green/blue",
+		"{/list*,path:4}": "/red/green/blue/%2Ffoo",
+		"{/keys*}":        "/comma=%2C/dot=./semi=%3B",
+		// Path-style parameter expansion.
+		"{;who}":         ";who=fred",
+		"{;half}":        ";half=50%25",
+		"{;empty}":       ";empty",
+		"{;v,empty,who}": ";v=6;empty;who=fred",
+		"{;v,bar,who}":   ";v=6;who=fred",
+		"{;x,y}":         ";x=1024;y=768",
+		"{;x,y,empty}":   ";x=1024;y=768;empty",
+		"{;x,y,undef}":   ";x=1024;y=768",
+		"{;hello:5}":     ";hello=Hello",
+		"{;list}":        ";list=red,green,blue",
+		"{;list*}":       ";list=red;list=green;list=blue",
+		"{;keys}":        ";keys=comma,%2C,dot,.,semi,%3B",
+		"{;keys*}":       ";comma=%2C;dot=.;semi=%3B",
+		// Form-style query expansion.
+		"{?who}":       "?who=fred",
+		"{?half}":      "?half=50%25",
+		"{?x,y}":       "?x=1024&y=768",
+		"{?x,y,empty}": "?x=1024&y=768&empty=",
+		"{?x,y,undef}": "?x=1024&y=768",
+		"{?var:3}":     "?var=val",
+		"{?list}":      "?list=red,green,blue",
+		"{?list*}":     "?list=red&list=green&list=blue",
+		"{?keys}":      "?keys=comma,%2C,dot,.,semi,%3B",
+		"{?keys*}":     "?comma=%2C&dot=.&semi=%3B",
+		// Form-style query continuation.
+		"{&who}":         "&who=fred",
+		"{&half}":        "&half=50%25",
+		"?fixed=yes{&x}": "?fixed=yes&x=1024",
+		"{&x,y,empty}":   "&x=1024&y=768&empty=",
+		"{&var:3}":       "&var=val",
+		"{&list}":        "&list=red,green,blue",
+		"{&list*}":       "&list=red&list=green&list=blue",
+		"{&keys}":        "&keys=comma,%2C,dot,.,semi,%3B",
+		"{&keys*}":       "&comma=%2C&dot=.&semi=%3B",
+	}
+
+	for template, want := range tests {
+		t.Run(template, func(t *testing.T) {
+			got, err := ExpandURITemplate(template, vars)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestExpandURITemplateError(t *testing.T) {
+	vars := map[string]any{"list": []string{"a"}}
+
+	for _, template := range []string{
+		"{var",
+		"var}",
+		"{}",
+		"{!var}",
+		"{va r}",
+		"{var:0}",
+		"{var:abc}",
+		"{list:3}",
+	} {
+		t.Run(template, func(t *testing.T) {
+			if _, err := ExpandURITemplate(template, vars); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -1,7 +1,17 @@
 package netparse
 
 import (
+	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// URLModeRFC3986 parses URLs with the net/url go package.
+	URLModeRFC3986 = "rfc3986"
+	// URLModeWHATWG parses URLs following the WHATWG URL Standard.
+	URLModeWHATWG = "whatwg"
 )
 
 // URLModel describes the data source data model.
@@ -9,7 +19,11 @@ import (
 // https://registry.terraform.io/modules/matti/urlparse/external/latest
 // https://registry.terraform.io/providers/northwood-labs/corefunc/latest/docs/data-sources/url_parse
 type URLModel struct {
-	Url         string
+	Url string
+	// Href is the URL that was parsed. It's Url in URLModeRFC3986, with the
+	// inferred scheme when there is one, and the serialization of the parsed
+	// URL in URLModeWHATWG, like the href of the Node.js URL class.
+	Href        string
 	Authority   string
 	Protocol    string
 	Scheme      string
@@ -17,12 +31,62 @@ type URLModel struct {
 	Username    string
 	Password    string
 	Host        string
-	Port        string
-	Path        string
-	Search      string
-	Query       string
-	Hash        string
-	Fragment    string
+	// HostType is the type of the host. It can be one of: ipv4, ipv6, domain
+	// or opaque. It's empty when the URL has no host.
+	HostType string
+	// Zone is the zone of a scoped IPv6 address, like eth0 in fe80::1%eth0.
+	Zone string
+	// Domain is the breakdown of the host when it's a domain name with a
+	// registrable domain.
+	Domain *DomainModel
+	Port   string
+	// EffectivePort is the port set in the URL or, when not set, the default
+	// port of the scheme. It's 0 when neither is known.
+	EffectivePort int
+	// HasEffectivePort reports whether the URL sets a port or its scheme has a
+	// default port, so the port 0 of custom://example.com:0 can be told apart
+	// from the missing port of custom://example.com.
+	HasEffectivePort bool
+	// IsDefaultPort reports whether EffectivePort is the default port of the
+	// scheme.
+	IsDefaultPort bool
+	Path          string
+	Search        string
+	Query         string
+	Hash          string
+	Fragment      string
+	// Origin is the ASCII serialization of the origin of the URL, like
+	// https://example.com:8443. It's empty when the origin is opaque, like
+	// for file: and data: URLs.
+	Origin string
+	// Opaque is the part after the scheme of a URL whose path doesn't start
+	// with a /, like isbn:0451450523 in urn:isbn:0451450523. Path is empty for
+	// these URLs in URLModeRFC3986.
+	Opaque string
+	// RawPath is the path as it's encoded in the URL, like /a%2Fb when Path is
+	// /a/b. In URLModeRFC3986, it's the path as written in Url.
+	RawPath string
+	// PathSegments are the percent-decoded segments of the path, split on the
+	// / characters that are not encoded.
+	PathSegments []string
+	// ForceQuery reports whether the URL has a ? with an empty query.
+	ForceQuery bool
+	// OmitHost reports whether the URL has a scheme and a path that starts with
+	// a /, but no authority, like file:/etc/hosts.
+	OmitHost bool
+	// RawFragment is the fragment as it's encoded in the URL.
+	RawFragment string
+	// Spans are the positions of the components in Url, by component name. A
+	// component that is not in the URL, or is empty, like the host of
+	// file:///etc/hosts, has no span. They are only set in URLModeRFC3986.
+	Spans map[string]URLSpan
+}
+
+// URLSpan is the position of a component in a URL string, in bytes. End is
+// exclusive, so the component is Url[Start:End].
+type URLSpan struct {
+	Start int
+	End   int
 }
 
 func ParseURL(u string) (*URLModel, error) {
@@ -38,33 +102,385 @@ func ParseURL(u string) (*URLModel, error) {
 	username := internalURL.User.Username()
 	password, _ := internalURL.User.Password()
 	host := internalURL.Hostname()
+	hostType, zone, domain := classifyHost(host)
 	port := internalURL.Port()
+	effectivePort, isDefaultPort := effectivePort(scheme, port)
 	path := internalURL.Path
 	search := renderSearch(internalURL)
 	query := internalURL.RawQuery
 	fragment := internalURL.Fragment
 	hash := renderHash(internalURL)
+	origin := renderOrigin(internalURL)
+	spans := urlSpans(u)
+
+	// EscapedPath encodes the characters that net/url would, like a space, so
+	// the path is taken from the input instead.
+	rawPath := internalURL.RawPath
+	if span, ok := spans["path"]; ok && rawPath == "" {
+		rawPath = u[span.Start:span.End]
+	}
 
 	return &URLModel{
-		Url:         u,
-		Authority:   authority,
-		Protocol:    protocol,
-		Scheme:      scheme,
-		Credentials: credentials,
-		Username:    username,
-		Password:    password,
-		Host:        host,
-		Port:        port,
-		Path:        path,
-		Search:      search,
-		Query:       query,
-		Hash:        hash,
-		Fragment:    fragment,
+		Url:              u,
+		Href:             u,
+		Authority:        authority,
+		Protocol:         protocol,
+		Scheme:           scheme,
+		Credentials:      credentials,
+		Username:         username,
+		Password:         password,
+		Host:             host,
+		HostType:         hostType,
+		Zone:             zone,
+		Domain:           domain,
+		Port:             port,
+		EffectivePort:    effectivePort,
+		HasEffectivePort: port != "" || isDefaultPort,
+		IsDefaultPort:    isDefaultPort,
+		Path:             path,
+		Search:           search,
+		Query:            query,
+		Hash:             hash,
+		Fragment:         fragment,
+		Origin:           origin,
+		Opaque:           internalURL.Opaque,
+		RawPath:          rawPath,
+		PathSegments:     splitPathSegments(rawPath),
+		ForceQuery:       internalURL.ForceQuery,
+		OmitHost:         internalURL.OmitHost,
+		RawFragment:      internalURL.EscapedFragment(),
+		Spans:            spans,
 	}, nil
 }
 
-func (u *URLModel) Validate() error {
-	return nil
+// ParseURLWithMode parses a URL with the parser selected by mode. An empty mode
+// defaults to URLModeRFC3986.
+func ParseURLWithMode(u string, mode string) (*URLModel, error) {
+	switch mode {
+	case "", URLModeRFC3986:
+		return ParseURL(u)
+	case URLModeWHATWG:
+		return ParseWHATWGURL(u)
+	default:
+		return nil, fmt.Errorf("unsupported URL mode %q, expected one of: %s, %s", mode, URLModeRFC3986, URLModeWHATWG)
+	}
+}
+
+// DefaultInferredScheme is the scheme of the URLs without one when the scheme
+// is inferred and no default scheme is set.
+const DefaultInferredScheme = "https"
+
+// ParseURLOptions configures ParseURLWithOptions.
+type ParseURLOptions struct {
+	// Mode selects the parser. Defaults to URLModeRFC3986.
+	Mode string
+	// InferScheme parses the inputs without a scheme, like example.com/path
+	// or db.internal:5432, as network locations with the DefaultScheme
+	// instead of treating the host as the scheme or as a path.
+	InferScheme bool
+	// DefaultScheme is the scheme of the inputs without one when InferScheme
+	// is set. Defaults to DefaultInferredScheme.
+	DefaultScheme string
+}
+
+// ParseURLWithOptions parses a URL with the parser selected by the options.
+// When the scheme is inferred, Url is still the input and the spans are
+// positions in the input, so the inferred scheme has no span.
+func ParseURLWithOptions(u string, opts ParseURLOptions) (*URLModel, error) {
+	if !opts.InferScheme {
+		return ParseURLWithMode(u, opts.Mode)
+	}
+
+	scheme := opts.DefaultScheme
+	if scheme == "" {
+		scheme = DefaultInferredScheme
+	}
+
+	if schemeLength(scheme+":") != len(scheme) {
+		return nil, fmt.Errorf("invalid default scheme %q", scheme)
+	}
+
+	inferred := InferURLScheme(u, scheme)
+
+	urlModel, err := ParseURLWithMode(inferred, opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix := len(inferred) - len(u); prefix > 0 {
+		urlModel.Url = u
+		urlModel.Spans = shiftSpans(urlModel.Spans, prefix)
+	}
+
+	return urlModel, nil
+}
+
+// InferURLScheme returns the URL with the scheme prepended when the input is a
+// network location without one. These inputs are an authority, like
+// db.internal:5432 or [::1]:8080, a host with a path, like example.com/path,
+// or a network-path reference, like //example.com/path. A host followed by a
+// port is not mistaken for a scheme because the part after the colon is only
+// digits, and neither is a username followed by a password, like
+// user:pass@db.internal:5432, because the part after the colon is a password,
+// an @ and a host with an optional port. Inputs with a registered scheme, like
+// mailto:ops@example.com or tel:5551234, and other inputs, like paths starting
+// with /, ., ? or #, and URLs with a scheme, are returned as is.
+func InferURLScheme(u string, scheme string) string {
+	if strings.HasPrefix(u, "//") {
+		return scheme + ":" + u
+	}
+
+	if u == "" || strings.ContainsAny(u[:1], "/.?#\\") {
+		return u
+	}
+
+	if n := schemeLength(u); n > 0 && (isRegisteredScheme(u[:n]) || !isPortPrefix(u[n+1:]) && !isPasswordPrefix(u[n+1:])) {
+		return u
+	}
+
+	return scheme + "://" + u
+}
+
+// isPortPrefix reports whether s starts with a port, that is, one or more
+// digits followed by the end of the authority.
+func isPortPrefix(s string) bool {
+	end := strings.IndexAny(s, "/?#")
+	if end == -1 {
+		end = len(s)
+	}
+
+	return end > 0 && strings.Trim(s[:end], "0123456789") == ""
+}
+
+// isPasswordPrefix reports whether s starts with the rest of an authority
+// after the username, that is, a password without colons, an @ and a host
+// with an optional port, followed by the end of the authority.
+func isPasswordPrefix(s string) bool {
+	end := strings.IndexAny(s, "/?#")
+	if end == -1 {
+		end = len(s)
+	}
+
+	password, hostPort, ok := strings.Cut(s[:end], "@")
+	if !ok || strings.Contains(password, ":") || hostPort == "" {
+		return false
+	}
+
+	host := hostPort
+	if i := strings.LastIndexByte(hostPort, ':'); i > strings.LastIndexByte(hostPort, ']') {
+		if !isPortPrefix(hostPort[i+1:]) {
+			return false
+		}
+		host = hostPort[:i]
+	}
+
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		return len(host) > 2
+	}
+
+	return host != "" && !strings.ContainsAny(host, ":@[]")
+}
+
+// shiftSpans moves the spans back by offset bytes, dropping the spans that
+// start before it.
+func shiftSpans(spans map[string]URLSpan, offset int) map[string]URLSpan {
+	if spans == nil {
+		return nil
+	}
+
+	shifted := make(map[string]URLSpan, len(spans))
+	for name, span := range spans {
+		if span.Start >= offset {
+			shifted[name] = URLSpan{Start: span.Start - offset, End: span.End - offset}
+		}
+	}
+
+	return shifted
+}
+
+// BuildURL renders a URL from its scheme, username, password, host, port, path,
+// query and fragment. Each component is escaped so that parsing the result
+// with ParseURL returns the same components. Opaque replaces the authority and
+// path when set, and RawPath and RawFragment choose the encoding of the path
+// and fragment when they are valid encodings of them. The other fields are
+// ignored.
+func BuildURL(u *URLModel) (string, error) {
+	if strings.Trim(u.Port, "0123456789") != "" {
+		return "", fmt.Errorf("invalid port %q", u.Port)
+	}
+	if port, err := strconv.Atoi(u.Port); u.Port != "" && (err != nil || port > 65535) {
+		return "", fmt.Errorf("invalid port %q: must be between 0 and 65535", u.Port)
+	}
+
+	host := u.Host
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		host = "[" + host + "]"
+	}
+	if u.Port != "" {
+		host += ":" + u.Port
+	}
+
+	internalURL := &url.URL{
+		Scheme:      u.Scheme,
+		Host:        host,
+		Path:        u.Path,
+		RawQuery:    percentEncodeString(u.Query, inQueryPercentEncodeSet),
+		Fragment:    u.Fragment,
+		Opaque:      u.Opaque,
+		RawPath:     u.RawPath,
+		RawFragment: u.RawFragment,
+		ForceQuery:  u.ForceQuery,
+		OmitHost:    u.OmitHost,
+	}
+
+	if u.Password != "" {
+		internalURL.User = url.UserPassword(u.Username, u.Password)
+	} else if u.Username != "" {
+		internalURL.User = url.User(u.Username)
+	}
+
+	built := internalURL.String()
+	if _, err := url.Parse(built); err != nil {
+		return "", err
+	}
+
+	return built, nil
+}
+
+// ResolveURL resolves a URL reference against an absolute base URL. The base
+// URL must be hierarchical, so URLs like mailto:user@example.com, whose path
+// doesn't start with /, can't be a base.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc3986#section-5
+func ResolveURL(base string, reference string) (string, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	if !baseURL.IsAbs() {
+		return "", fmt.Errorf("base URL %q is not absolute", base)
+	}
+
+	if baseURL.Opaque != "" {
+		return "", fmt.Errorf("base URL %q is not hierarchical", base)
+	}
+
+	referenceURL, err := url.Parse(reference)
+	if err != nil {
+		return "", err
+	}
+
+	return baseURL.ResolveReference(referenceURL).String(), nil
+}
+
+// urlSpans returns the spans of the components of a URL that net/url parses
+// successfully, splitting it the same way. The spans cover the components as
+// written, so the host span includes the brackets of an IPv6 address, and
+// empty components have no span. The names of the components are: scheme,
+// opaque, username, password, host, port, path, query and fragment.
+func urlSpans(u string) map[string]URLSpan {
+	spans := map[string]URLSpan{}
+	span := func(name string, start int, end int) {
+		if start < end {
+			spans[name] = URLSpan{Start: start, End: end}
+		}
+	}
+
+	end := len(u)
+	if i := strings.IndexByte(u, '#'); i >= 0 {
+		span("fragment", i+1, len(u))
+		end = i
+	}
+
+	start := 0
+	if i := schemeLength(u[:end]); i > 0 {
+		span("scheme", 0, i)
+		start = i + 1
+	}
+
+	if i := strings.IndexByte(u[start:end], '?'); i >= 0 {
+		span("query", start+i+1, end)
+		end = start + i
+	}
+
+	rest := u[start:end]
+	if start > 0 && !strings.HasPrefix(rest, "/") {
+		span("opaque", start, end)
+
+		return spans
+	}
+
+	if strings.HasPrefix(rest, "//") && (start > 0 || !strings.HasPrefix(rest, "///")) {
+		authorityStart := start + 2
+		authorityEnd := end
+		if i := strings.IndexByte(u[authorityStart:end], '/'); i >= 0 {
+			authorityEnd = authorityStart + i
+		}
+
+		hostStart := authorityStart
+		if i := strings.LastIndexByte(u[authorityStart:authorityEnd], '@'); i >= 0 {
+			userinfoEnd := authorityStart + i
+			if j := strings.IndexByte(u[authorityStart:userinfoEnd], ':'); j >= 0 {
+				span("username", authorityStart, authorityStart+j)
+				span("password", authorityStart+j+1, userinfoEnd)
+			} else {
+				span("username", authorityStart, userinfoEnd)
+			}
+			hostStart = userinfoEnd + 1
+		}
+
+		hostPort := u[hostStart:authorityEnd]
+		if i := strings.LastIndexByte(hostPort, ':'); i >= 0 && i > strings.LastIndexByte(hostPort, ']') {
+			span("host", hostStart, hostStart+i)
+			span("port", hostStart+i+1, authorityEnd)
+		} else {
+			span("host", hostStart, authorityEnd)
+		}
+
+		start = authorityEnd
+	}
+
+	span("path", start, end)
+
+	return spans
+}
+
+// schemeLength returns the length of the scheme of a URL, or 0 when it has
+// none, following the rules of net/url.
+func schemeLength(u string) int {
+	for i := 0; i < len(u); i++ {
+		c := u[i]
+		switch {
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9' || c == '+' || c == '-' || c == '.':
+			if i == 0 {
+				return 0
+			}
+		case c == ':':
+			return i
+		default:
+			return 0
+		}
+	}
+
+	return 0
+}
+
+// splitPathSegments splits an encoded path on / and percent-decodes each
+// segment. The leading / is removed, so an empty path has no segments and the
+// path / has one empty segment.
+func splitPathSegments(rawPath string) []string {
+	if rawPath == "" {
+		return []string{}
+	}
+
+	segments := strings.Split(strings.TrimPrefix(rawPath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = percentDecode(segment)
+	}
+
+	return segments
 }
 
 func renderHash(u *url.URL) string {
@@ -101,7 +517,3 @@ func renderAuthority(u *url.URL) string {
 
 	return credentialsComponent + u.Hostname() + portComponent
 }
-
-func UrlValidate(u string) error {
-	return nil
-}
@@ -0,0 +1,1209 @@
+package netparse
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// URLPatternComponents are the names of the URL components matched by a
+// URLPattern, in order.
+var URLPatternComponents = []string{
+	"protocol",
+	"username",
+	"password",
+	"hostname",
+	"port",
+	"pathname",
+	"search",
+	"hash",
+}
+
+// URLPatternOptions are the options used to compile a URLPattern.
+type URLPatternOptions struct {
+	// IgnoreCase makes the matching case-insensitive.
+	IgnoreCase bool
+}
+
+// URLPattern is a compiled pattern that matches URLs, as defined by the
+// WHATWG URLPattern Standard.
+// References used.
+// https://urlpattern.spec.whatwg.org/
+type URLPattern struct {
+	components map[string]*urlPatternComponent
+}
+
+// URLPatternGroups maps each URL component to the groups captured in it. An
+// optional group that didn't match is nil.
+type URLPatternGroups map[string]map[string]*string
+
+// urlPatternComponent is a compiled component of a URLPattern.
+// https://urlpattern.spec.whatwg.org/#component
+type urlPatternComponent struct {
+	pattern string
+	regexp  *regexp.Regexp
+	names   []string
+}
+
+// urlPatternEncoder canonicalizes the fixed text of a component pattern.
+type urlPatternEncoder func(value string) (string, error)
+
+// urlPatternParseOptions are the options of a component pattern.
+// https://urlpattern.spec.whatwg.org/#options-header
+type urlPatternParseOptions struct {
+	delimiter  string
+	prefix     string
+	ignoreCase bool
+}
+
+// CompileURLPattern compiles a URLPattern from a constructor string, like
+// https://*.example.com/api/:version/*. The pattern must be absolute. The
+// username, password, search and hash match anything when not set, and the
+// port matches the empty string when the pattern sets a hostname. The regexp
+// groups, like (\d+), use the Go RE2 syntax instead of the JavaScript one, so
+// lookarounds like (?!...) and backreferences are not supported.
+// https://urlpattern.spec.whatwg.org/#constructor-string-parsing
+func CompileURLPattern(pattern string, options URLPatternOptions) (*URLPattern, error) {
+	init, err := parseURLPatternConstructorString(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := init["protocol"]; !ok {
+		return nil, fmt.Errorf("URL pattern %q is not absolute", pattern)
+	}
+
+	return CompileURLPatternComponents(init, options)
+}
+
+// CompileURLPatternComponents compiles a URLPattern from the pattern of each
+// component. The components that are not set match anything. The protocol can
+// end with ":", the search can start with "?" and the hash with "#".
+// https://urlpattern.spec.whatwg.org/#url-pattern-create
+func CompileURLPatternComponents(init map[string]string, options URLPatternOptions) (*URLPattern, error) {
+	processed := map[string]string{}
+	for name, value := range init {
+		if !slices.Contains(URLPatternComponents, name) {
+			return nil, fmt.Errorf("unsupported URL pattern component %q, expected one of: %s", name, strings.Join(URLPatternComponents, ", "))
+		}
+
+		switch name {
+		case "protocol":
+			value = strings.TrimSuffix(value, ":")
+		case "search":
+			value = strings.TrimPrefix(value, "?")
+		case "hash":
+			value = strings.TrimPrefix(value, "#")
+		}
+		processed[name] = value
+	}
+
+	for _, name := range URLPatternComponents {
+		if _, ok := processed[name]; !ok {
+			processed[name] = "*"
+		}
+	}
+
+	if port, ok := specialSchemes[processed["protocol"]]; ok && processed["port"] == strconv.Itoa(port) {
+		processed["port"] = ""
+	}
+
+	defaultOptions := urlPatternParseOptions{}
+	hostnameOptions := urlPatternParseOptions{delimiter: "."}
+	pathnameOptions := urlPatternParseOptions{delimiter: "/", prefix: "/", ignoreCase: options.IgnoreCase}
+	compileOptions := urlPatternParseOptions{ignoreCase: options.IgnoreCase}
+
+	p := &URLPattern{components: map[string]*urlPatternComponent{}}
+	compile := func(name string, encode urlPatternEncoder, parseOptions urlPatternParseOptions) error {
+		component, err := compileURLPatternComponent(processed[name], encode, parseOptions)
+		if err != nil {
+			return fmt.Errorf("invalid %s pattern %q: %w", name, processed[name], err)
+		}
+		p.components[name] = component
+
+		return nil
+	}
+
+	if err := compile("protocol", canonicalizeURLPatternProtocol, defaultOptions); err != nil {
+		return nil, err
+	}
+
+	hostnameEncoder := canonicalizeURLPatternHostname
+	pathnameEncoder := canonicalizeURLPatternOpaquePathname
+	if p.components["protocol"].matchesSpecialScheme() {
+		hostnameEncoder = canonicalizeURLPatternDomainName
+		pathnameEncoder = canonicalizeURLPatternPathname
+	} else {
+		pathnameOptions = compileOptions
+	}
+
+	if isIPv6HostnamePattern(processed["hostname"]) {
+		hostnameEncoder = canonicalizeURLPatternIPv6Hostname
+	}
+
+	for _, c := range []struct {
+		name    string
+		encode  urlPatternEncoder
+		options urlPatternParseOptions
+	}{
+		{"username", canonicalizeURLPatternUsername, defaultOptions},
+		{"password", canonicalizeURLPatternPassword, defaultOptions},
+		{"hostname", hostnameEncoder, hostnameOptions},
+		{"port", canonicalizeURLPatternPort, defaultOptions},
+		{"pathname", pathnameEncoder, pathnameOptions},
+		{"search", canonicalizeURLPatternSearch, compileOptions},
+		{"hash", canonicalizeURLPatternHash, compileOptions},
+	} {
+		if err := compile(c.name, c.encode, c.options); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Pattern returns the pattern of the component with the given name.
+func (p *URLPattern) Pattern(component string) string {
+	return p.components[component].pattern
+}
+
+// Match reports whether the URL matches the pattern and returns the groups
+// captured in each component. A URL that can't be parsed doesn't match.
+// https://urlpattern.spec.whatwg.org/#url-pattern-match
+func (p *URLPattern) Match(u string) (URLPatternGroups, bool) {
+	url, err := parseWHATWG(u, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	var search, hash string
+	if url.query != nil {
+		search = *url.query
+	}
+	if url.fragment != nil {
+		hash = *url.fragment
+	}
+
+	inputs := map[string]string{
+		"protocol": url.scheme,
+		"username": url.username,
+		"password": url.password,
+		"hostname": url.hostname(),
+		"port":     url.portString(),
+		"pathname": url.pathname(),
+		"search":   search,
+		"hash":     hash,
+	}
+
+	groups := URLPatternGroups{}
+	for _, name := range URLPatternComponents {
+		componentGroups, ok := p.components[name].match(inputs[name])
+		if !ok {
+			return nil, false
+		}
+		groups[name] = componentGroups
+	}
+
+	return groups, true
+}
+
+func (c *urlPatternComponent) match(input string) (map[string]*string, bool) {
+	indexes := c.regexp.FindStringSubmatchIndex(input)
+	if indexes == nil {
+		return nil, false
+	}
+
+	groups := make(map[string]*string, len(c.names))
+	for i, name := range c.names {
+		start, end := indexes[2*i+2], indexes[2*i+3]
+		if start < 0 {
+			groups[name] = nil
+			continue
+		}
+		value := input[start:end]
+		groups[name] = &value
+	}
+
+	return groups, true
+}
+
+// matchesSpecialScheme reports whether the protocol component matches any
+// special scheme.
+// https://urlpattern.spec.whatwg.org/#protocol-component-matches-a-special-scheme
+func (c *urlPatternComponent) matchesSpecialScheme() bool {
+	for scheme := range specialSchemes {
+		if c.regexp.MatchString(scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileURLPatternComponent implements compile a component.
+// https://urlpattern.spec.whatwg.org/#compile-a-component
+func compileURLPatternComponent(pattern string, encode urlPatternEncoder, options urlPatternParseOptions) (*urlPatternComponent, error) {
+	parts, err := parseURLPatternString(pattern, options, encode)
+	if err != nil {
+		return nil, err
+	}
+
+	source, names := generateURLPatternRegexp(parts, options)
+	if options.ignoreCase {
+		source = "(?i)" + source
+	}
+
+	re, err := regexp.Compile(source)
+	if err != nil {
+		return nil, fmt.Errorf("regexp groups use the Go RE2 syntax, without lookarounds or backreferences: %w", err)
+	}
+
+	if re.NumSubexp() != len(names) {
+		return nil, fmt.Errorf("regexp groups must not contain capturing groups")
+	}
+
+	return &urlPatternComponent{pattern: pattern, regexp: re, names: names}, nil
+}
+
+type urlPatternTokenType int
+
+const (
+	urlPatternTokenInvalidChar urlPatternTokenType = iota
+	urlPatternTokenOpen
+	urlPatternTokenClose
+	urlPatternTokenRegexp
+	urlPatternTokenName
+	urlPatternTokenChar
+	urlPatternTokenEscapedChar
+	urlPatternTokenOtherModifier
+	urlPatternTokenAsterisk
+	urlPatternTokenEnd
+)
+
+// urlPatternToken is a token of a pattern string. index is the position of
+// the token in the input code points.
+// https://urlpattern.spec.whatwg.org/#token
+type urlPatternToken struct {
+	typ   urlPatternTokenType
+	index int
+	value string
+}
+
+// urlPatternTokenizer implements the tokenizer of pattern strings. When
+// lenient is true, invalid code points are returned as invalid-char tokens
+// instead of failing.
+// https://urlpattern.spec.whatwg.org/#tokenizing
+type urlPatternTokenizer struct {
+	input     []rune
+	lenient   bool
+	tokens    []urlPatternToken
+	index     int
+	nextIndex int
+	codePoint rune
+}
+
+func tokenizeURLPattern(input string, lenient bool) ([]urlPatternToken, error) {
+	t := &urlPatternTokenizer{input: []rune(input), lenient: lenient}
+
+	for t.index < len(t.input) {
+		t.seekAndGetNextCodePoint(t.index)
+
+		var err error
+		switch t.codePoint {
+		case '*':
+			t.addTokenWithDefaultPositionAndLength(urlPatternTokenAsterisk)
+		case '+', '?':
+			t.addTokenWithDefaultPositionAndLength(urlPatternTokenOtherModifier)
+		case '\\':
+			if t.index == len(t.input)-1 {
+				err = t.processError(t.nextIndex, t.index)
+				break
+			}
+			escapedIndex := t.nextIndex
+			t.getNextCodePoint()
+			t.addTokenWithDefaultLength(urlPatternTokenEscapedChar, t.nextIndex, escapedIndex)
+		case '{':
+			t.addTokenWithDefaultPositionAndLength(urlPatternTokenOpen)
+		case '}':
+			t.addTokenWithDefaultPositionAndLength(urlPatternTokenClose)
+		case ':':
+			err = t.tokenizeName()
+		case '(':
+			err = t.tokenizeRegexp()
+		default:
+			t.addTokenWithDefaultPositionAndLength(urlPatternTokenChar)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.addTokenWithDefaultLength(urlPatternTokenEnd, t.index, t.index)
+
+	return t.tokens, nil
+}
+
+func (t *urlPatternTokenizer) tokenizeName() error {
+	namePosition := t.nextIndex
+	nameStart := namePosition
+	for namePosition < len(t.input) {
+		t.seekAndGetNextCodePoint(namePosition)
+		if !isURLPatternNameCodePoint(t.codePoint, namePosition == nameStart) {
+			break
+		}
+		namePosition = t.nextIndex
+	}
+
+	if namePosition <= nameStart {
+		return t.processError(nameStart, t.index)
+	}
+
+	t.addTokenWithDefaultLength(urlPatternTokenName, namePosition, nameStart)
+
+	return nil
+}
+
+func (t *urlPatternTokenizer) tokenizeRegexp() error {
+	depth := 1
+	regexpPosition := t.nextIndex
+	regexpStart := regexpPosition
+
+	for regexpPosition < len(t.input) {
+		t.seekAndGetNextCodePoint(regexpPosition)
+
+		if t.codePoint > unicode.MaxASCII || regexpPosition == regexpStart && t.codePoint == '?' {
+			return t.processError(regexpStart, t.index)
+		}
+
+		if t.codePoint == '\\' {
+			if regexpPosition == len(t.input)-1 {
+				return t.processError(regexpStart, t.index)
+			}
+			t.getNextCodePoint()
+			if t.codePoint > unicode.MaxASCII {
+				return t.processError(regexpStart, t.index)
+			}
+			regexpPosition = t.nextIndex
+			continue
+		}
+
+		if t.codePoint == ')' {
+			depth--
+			if depth == 0 {
+				regexpPosition = t.nextIndex
+				break
+			}
+		} else if t.codePoint == '(' {
+			depth++
+			if regexpPosition == len(t.input)-1 {
+				return t.processError(regexpStart, t.index)
+			}
+			temporaryPosition := t.nextIndex
+			t.getNextCodePoint()
+			if t.codePoint != '?' {
+				return t.processError(regexpStart, t.index)
+			}
+			t.nextIndex = temporaryPosition
+		}
+
+		regexpPosition = t.nextIndex
+	}
+
+	if depth != 0 {
+		return t.processError(regexpStart, t.index)
+	}
+
+	regexpLength := regexpPosition - regexpStart - 1
+	if regexpLength == 0 {
+		return t.processError(regexpStart, t.index)
+	}
+
+	t.addToken(urlPatternTokenRegexp, regexpPosition, regexpStart, regexpLength)
+
+	return nil
+}
+
+func (t *urlPatternTokenizer) getNextCodePoint() {
+	t.codePoint = t.input[t.nextIndex]
+	t.nextIndex++
+}
+
+func (t *urlPatternTokenizer) seekAndGetNextCodePoint(index int) {
+	t.nextIndex = index
+	t.getNextCodePoint()
+}
+
+func (t *urlPatternTokenizer) addToken(typ urlPatternTokenType, nextPosition int, valuePosition int, valueLength int) {
+	t.tokens = append(t.tokens, urlPatternToken{
+		typ:   typ,
+		index: t.index,
+		value: string(t.input[valuePosition : valuePosition+valueLength]),
+	})
+	t.index = nextPosition
+}
+
+func (t *urlPatternTokenizer) addTokenWithDefaultLength(typ urlPatternTokenType, nextPosition int, valuePosition int) {
+	t.addToken(typ, nextPosition, valuePosition, nextPosition-valuePosition)
+}
+
+func (t *urlPatternTokenizer) addTokenWithDefaultPositionAndLength(typ urlPatternTokenType) {
+	t.addTokenWithDefaultLength(typ, t.nextIndex, t.index)
+}
+
+func (t *urlPatternTokenizer) processError(nextPosition int, valuePosition int) error {
+	if !t.lenient {
+		return fmt.Errorf("invalid pattern syntax at position %d", t.index)
+	}
+
+	t.addTokenWithDefaultLength(urlPatternTokenInvalidChar, nextPosition, valuePosition)
+
+	return nil
+}
+
+// isURLPatternNameCodePoint reports whether r can be part of a group name,
+// which follows the JavaScript identifier syntax.
+// https://urlpattern.spec.whatwg.org/#is-a-valid-name-code-point
+func isURLPatternNameCodePoint(r rune, first bool) bool {
+	if r == '$' || r == '_' || unicode.In(r, unicode.L, unicode.Nl, unicode.Other_ID_Start) {
+		return true
+	}
+
+	return !first && (r == '‌' || r == '‍' || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc, unicode.Other_ID_Continue))
+}
+
+type urlPatternPartType int
+
+const (
+	urlPatternPartFixedText urlPatternPartType = iota
+	urlPatternPartRegexp
+	urlPatternPartSegmentWildcard
+	urlPatternPartFullWildcard
+)
+
+// urlPatternPart is a part of a component pattern. The modifier is one of: "",
+// "?", "*" or "+".
+// https://urlpattern.spec.whatwg.org/#part
+type urlPatternPart struct {
+	typ      urlPatternPartType
+	value    string
+	modifier string
+	name     string
+	prefix   string
+	suffix   string
+}
+
+// urlPatternParser implements the pattern parser.
+// https://urlpattern.spec.whatwg.org/#parsing-patterns
+type urlPatternParser struct {
+	tokens                []urlPatternToken
+	encode                urlPatternEncoder
+	segmentWildcardRegexp string
+	parts                 []urlPatternPart
+	pendingFixedValue     string
+	index                 int
+	nextNumericName       int
+}
+
+// fullWildcardRegexp is the regexp of the * wildcard.
+const fullWildcardRegexp = ".*"
+
+// parseURLPatternString implements parse a pattern string.
+// https://urlpattern.spec.whatwg.org/#parse-a-pattern-string
+func parseURLPatternString(input string, options urlPatternParseOptions, encode urlPatternEncoder) ([]urlPatternPart, error) {
+	tokens, err := tokenizeURLPattern(input, false)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &urlPatternParser{
+		tokens:                tokens,
+		encode:                encode,
+		segmentWildcardRegexp: segmentWildcardRegexp(options),
+	}
+
+	for p.index < len(p.tokens) {
+		charToken := p.tryConsume(urlPatternTokenChar)
+		nameToken := p.tryConsume(urlPatternTokenName)
+		regexpOrWildcardToken := p.tryConsumeRegexpOrWildcard(nameToken)
+
+		if nameToken != nil || regexpOrWildcardToken != nil {
+			var prefix string
+			if charToken != nil {
+				prefix = charToken.value
+			}
+
+			if prefix != "" && prefix != options.prefix {
+				p.pendingFixedValue += prefix
+				prefix = ""
+			}
+
+			if err := p.maybeAddPartFromPendingFixedValue(); err != nil {
+				return nil, err
+			}
+
+			modifierToken := p.tryConsumeModifier()
+			if err := p.addPart(prefix, nameToken, regexpOrWildcardToken, "", modifierToken); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		fixedToken := charToken
+		if fixedToken == nil {
+			fixedToken = p.tryConsume(urlPatternTokenEscapedChar)
+		}
+		if fixedToken != nil {
+			p.pendingFixedValue += fixedToken.value
+			continue
+		}
+
+		if openToken := p.tryConsume(urlPatternTokenOpen); openToken != nil {
+			prefix := p.consumeText()
+			nameToken = p.tryConsume(urlPatternTokenName)
+			regexpOrWildcardToken = p.tryConsumeRegexpOrWildcard(nameToken)
+			suffix := p.consumeText()
+
+			if _, err := p.consumeRequired(urlPatternTokenClose); err != nil {
+				return nil, err
+			}
+
+			modifierToken := p.tryConsumeModifier()
+			if err := p.addPart(prefix, nameToken, regexpOrWildcardToken, suffix, modifierToken); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := p.maybeAddPartFromPendingFixedValue(); err != nil {
+			return nil, err
+		}
+
+		if _, err := p.consumeRequired(urlPatternTokenEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.parts, nil
+}
+
+func (p *urlPatternParser) tryConsume(typ urlPatternTokenType) *urlPatternToken {
+	if p.tokens[p.index].typ != typ {
+		return nil
+	}
+
+	token := p.tokens[p.index]
+	p.index++
+
+	return &token
+}
+
+func (p *urlPatternParser) tryConsumeModifier() *urlPatternToken {
+	if token := p.tryConsume(urlPatternTokenOtherModifier); token != nil {
+		return token
+	}
+
+	return p.tryConsume(urlPatternTokenAsterisk)
+}
+
+func (p *urlPatternParser) tryConsumeRegexpOrWildcard(nameToken *urlPatternToken) *urlPatternToken {
+	token := p.tryConsume(urlPatternTokenRegexp)
+	if nameToken == nil && token == nil {
+		token = p.tryConsume(urlPatternTokenAsterisk)
+	}
+
+	return token
+}
+
+func (p *urlPatternParser) consumeRequired(typ urlPatternTokenType) (*urlPatternToken, error) {
+	token := p.tryConsume(typ)
+	if token == nil {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.tokens[p.index].value, p.tokens[p.index].index)
+	}
+
+	return token, nil
+}
+
+func (p *urlPatternParser) consumeText() string {
+	var result string
+	for {
+		token := p.tryConsume(urlPatternTokenChar)
+		if token == nil {
+			token = p.tryConsume(urlPatternTokenEscapedChar)
+		}
+		if token == nil {
+			return result
+		}
+		result += token.value
+	}
+}
+
+func (p *urlPatternParser) maybeAddPartFromPendingFixedValue() error {
+	if p.pendingFixedValue == "" {
+		return nil
+	}
+
+	encoded, err := p.encode(p.pendingFixedValue)
+	if err != nil {
+		return err
+	}
+	p.pendingFixedValue = ""
+	p.parts = append(p.parts, urlPatternPart{typ: urlPatternPartFixedText, value: encoded})
+
+	return nil
+}
+
+// addPart implements add a part.
+// https://urlpattern.spec.whatwg.org/#add-a-part
+func (p *urlPatternParser) addPart(prefix string, nameToken *urlPatternToken, regexpOrWildcardToken *urlPatternToken, suffix string, modifierToken *urlPatternToken) error {
+	var modifier string
+	if modifierToken != nil {
+		modifier = modifierToken.value
+	}
+
+	if nameToken == nil && regexpOrWildcardToken == nil && modifier == "" {
+		p.pendingFixedValue += prefix
+		return nil
+	}
+
+	if err := p.maybeAddPartFromPendingFixedValue(); err != nil {
+		return err
+	}
+
+	if nameToken == nil && regexpOrWildcardToken == nil {
+		if prefix == "" {
+			return nil
+		}
+
+		encoded, err := p.encode(prefix)
+		if err != nil {
+			return err
+		}
+		p.parts = append(p.parts, urlPatternPart{typ: urlPatternPartFixedText, value: encoded, modifier: modifier})
+
+		return nil
+	}
+
+	regexpValue := p.segmentWildcardRegexp
+	if regexpOrWildcardToken != nil {
+		regexpValue = fullWildcardRegexp
+		if regexpOrWildcardToken.typ == urlPatternTokenRegexp {
+			regexpValue = regexpOrWildcardToken.value
+		}
+	}
+
+	typ := urlPatternPartRegexp
+	switch regexpValue {
+	case p.segmentWildcardRegexp:
+		typ = urlPatternPartSegmentWildcard
+		regexpValue = ""
+	case fullWildcardRegexp:
+		typ = urlPatternPartFullWildcard
+		regexpValue = ""
+	}
+
+	var name string
+	if nameToken != nil {
+		name = nameToken.value
+	} else {
+		name = strconv.Itoa(p.nextNumericName)
+		p.nextNumericName++
+	}
+
+	if slices.ContainsFunc(p.parts, func(part urlPatternPart) bool { return part.typ != urlPatternPartFixedText && part.name == name }) {
+		return fmt.Errorf("duplicate group name %q", name)
+	}
+
+	encodedPrefix, err := p.encode(prefix)
+	if err != nil {
+		return err
+	}
+
+	encodedSuffix, err := p.encode(suffix)
+	if err != nil {
+		return err
+	}
+
+	p.parts = append(p.parts, urlPatternPart{
+		typ:      typ,
+		value:    regexpValue,
+		modifier: modifier,
+		name:     name,
+		prefix:   encodedPrefix,
+		suffix:   encodedSuffix,
+	})
+
+	return nil
+}
+
+// generateURLPatternRegexp implements generate a regular expression and name
+// list.
+// https://urlpattern.spec.whatwg.org/#generate-a-regular-expression-and-name-list
+func generateURLPatternRegexp(parts []urlPatternPart, options urlPatternParseOptions) (string, []string) {
+	var b strings.Builder
+	names := []string{}
+
+	b.WriteString("^")
+	for _, part := range parts {
+		if part.typ == urlPatternPartFixedText {
+			if part.modifier == "" {
+				b.WriteString(regexp.QuoteMeta(part.value))
+			} else {
+				b.WriteString("(?:" + regexp.QuoteMeta(part.value) + ")" + part.modifier)
+			}
+			continue
+		}
+
+		names = append(names, part.name)
+
+		regexpValue := part.value
+		switch part.typ {
+		case urlPatternPartSegmentWildcard:
+			regexpValue = segmentWildcardRegexp(options)
+		case urlPatternPartFullWildcard:
+			regexpValue = fullWildcardRegexp
+		}
+
+		prefix := regexp.QuoteMeta(part.prefix)
+		suffix := regexp.QuoteMeta(part.suffix)
+
+		switch {
+		case part.prefix == "" && part.suffix == "" && (part.modifier == "" || part.modifier == "?"):
+			b.WriteString("(" + regexpValue + ")" + part.modifier)
+		case part.prefix == "" && part.suffix == "":
+			b.WriteString("((?:" + regexpValue + ")" + part.modifier + ")")
+		case part.modifier == "" || part.modifier == "?":
+			b.WriteString("(?:" + prefix + "(" + regexpValue + ")" + suffix + ")" + part.modifier)
+		default:
+			b.WriteString("(?:" + prefix + "((?:" + regexpValue + ")(?:" + suffix + prefix + "(?:" + regexpValue + "))*)" + suffix + ")")
+			if part.modifier == "*" {
+				b.WriteString("?")
+			}
+		}
+	}
+	b.WriteString("$")
+
+	return b.String(), names
+}
+
+// segmentWildcardRegexp implements generate a segment wildcard regexp.
+// https://urlpattern.spec.whatwg.org/#generate-a-segment-wildcard-regexp
+func segmentWildcardRegexp(options urlPatternParseOptions) string {
+	if options.delimiter == "" {
+		return "(?s:.)+?"
+	}
+
+	return "[^" + regexp.QuoteMeta(options.delimiter) + "]+?"
+}
+
+// urlPatternConstructorParser implements the constructor string parser, which
+// splits a pattern string into the pattern of each component.
+// https://urlpattern.spec.whatwg.org/#constructor-string-parsing
+type urlPatternConstructorParser struct {
+	input                      []rune
+	tokens                     []urlPatternToken
+	result                     map[string]string
+	componentStart             int
+	tokenIndex                 int
+	tokenIncrement             int
+	groupDepth                 int
+	hostnameIPv6BracketDepth   int
+	protocolMatchesSpecialFlag bool
+	state                      string
+}
+
+func parseURLPatternConstructorString(input string) (map[string]string, error) {
+	tokens, err := tokenizeURLPattern(input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &urlPatternConstructorParser{
+		input:  []rune(input),
+		tokens: tokens,
+		result: map[string]string{},
+		state:  "init",
+	}
+
+	for p.tokenIndex < len(p.tokens) {
+		p.tokenIncrement = 1
+
+		if p.tokens[p.tokenIndex].typ == urlPatternTokenEnd {
+			if p.state == "init" {
+				p.rewind()
+				switch {
+				case p.isHashPrefix():
+					p.changeState("hash", 1)
+				case p.isSearchPrefix():
+					p.changeState("search", 1)
+				default:
+					p.changeState("pathname", 0)
+				}
+				p.tokenIndex += p.tokenIncrement
+				continue
+			}
+
+			if p.state == "authority" {
+				p.rewindAndSetState("hostname")
+				p.tokenIndex += p.tokenIncrement
+				continue
+			}
+
+			p.changeState("done", 0)
+			break
+		}
+
+		if p.groupDepth > 0 {
+			if p.tokens[p.tokenIndex].typ == urlPatternTokenClose {
+				p.groupDepth--
+			} else {
+				p.tokenIndex += p.tokenIncrement
+				continue
+			}
+		}
+
+		if p.tokens[p.tokenIndex].typ == urlPatternTokenOpen {
+			p.groupDepth++
+			p.tokenIndex += p.tokenIncrement
+			continue
+		}
+
+		switch p.state {
+		case "init":
+			if p.isNonSpecialPatternChar(p.tokenIndex, ":") {
+				p.rewindAndSetState("protocol")
+			}
+		case "protocol":
+			if p.isNonSpecialPatternChar(p.tokenIndex, ":") {
+				if err := p.computeProtocolMatchesSpecialSchemeFlag(); err != nil {
+					return nil, err
+				}
+
+				nextState, skip := "pathname", 1
+				if p.isNonSpecialPatternChar(p.tokenIndex+1, "/") && p.isNonSpecialPatternChar(p.tokenIndex+2, "/") {
+					nextState, skip = "authority", 3
+				} else if p.protocolMatchesSpecialFlag {
+					nextState = "authority"
+				}
+				p.changeState(nextState, skip)
+			}
+		case "authority":
+			if p.isNonSpecialPatternChar(p.tokenIndex, "@") {
+				p.rewindAndSetState("username")
+			} else if p.isNonSpecialPatternChar(p.tokenIndex, "/") || p.isSearchPrefix() || p.isHashPrefix() {
+				p.rewindAndSetState("hostname")
+			}
+		case "username":
+			if p.isNonSpecialPatternChar(p.tokenIndex, ":") {
+				p.changeState("password", 1)
+			} else if p.isNonSpecialPatternChar(p.tokenIndex, "@") {
+				p.changeState("hostname", 1)
+			}
+		case "password":
+			if p.isNonSpecialPatternChar(p.tokenIndex, "@") {
+				p.changeState("hostname", 1)
+			}
+		case "hostname":
+			switch {
+			case p.isNonSpecialPatternChar(p.tokenIndex, "["):
+				p.hostnameIPv6BracketDepth++
+			case p.isNonSpecialPatternChar(p.tokenIndex, "]"):
+				p.hostnameIPv6BracketDepth--
+			case p.isNonSpecialPatternChar(p.tokenIndex, ":") && p.hostnameIPv6BracketDepth == 0:
+				p.changeState("port", 1)
+			case p.isNonSpecialPatternChar(p.tokenIndex, "/"):
+				p.changeState("pathname", 0)
+			case p.isSearchPrefix():
+				p.changeState("search", 1)
+			case p.isHashPrefix():
+				p.changeState("hash", 1)
+			}
+		case "port":
+			switch {
+			case p.isNonSpecialPatternChar(p.tokenIndex, "/"):
+				p.changeState("pathname", 0)
+			case p.isSearchPrefix():
+				p.changeState("search", 1)
+			case p.isHashPrefix():
+				p.changeState("hash", 1)
+			}
+		case "pathname":
+			if p.isSearchPrefix() {
+				p.changeState("search", 1)
+			} else if p.isHashPrefix() {
+				p.changeState("hash", 1)
+			}
+		case "search":
+			if p.isHashPrefix() {
+				p.changeState("hash", 1)
+			}
+		}
+
+		p.tokenIndex += p.tokenIncrement
+	}
+
+	if _, ok := p.result["hostname"]; ok {
+		if _, ok := p.result["port"]; !ok {
+			p.result["port"] = ""
+		}
+	}
+
+	return p.result, nil
+}
+
+// changeState implements change state.
+// https://urlpattern.spec.whatwg.org/#change-state
+func (p *urlPatternConstructorParser) changeState(newState string, skip int) {
+	if p.state != "init" && p.state != "authority" && p.state != "done" {
+		p.result[p.state] = p.makeComponentString()
+	}
+
+	if p.state != "init" && newState != "done" {
+		beforeHostname := slices.Contains([]string{"protocol", "authority", "username", "password"}, p.state)
+		beforePathname := beforeHostname || p.state == "hostname" || p.state == "port"
+		beforeSearch := beforePathname || p.state == "pathname"
+
+		if _, ok := p.result["hostname"]; !ok && beforeHostname && slices.Contains([]string{"port", "pathname", "search", "hash"}, newState) {
+			p.result["hostname"] = ""
+		}
+
+		if _, ok := p.result["pathname"]; !ok && beforePathname && (newState == "search" || newState == "hash") {
+			p.result["pathname"] = ""
+			if p.protocolMatchesSpecialFlag {
+				p.result["pathname"] = "/"
+			}
+		}
+
+		if _, ok := p.result["search"]; !ok && beforeSearch && newState == "hash" {
+			p.result["search"] = ""
+		}
+	}
+
+	p.state = newState
+	p.tokenIndex += skip
+	p.componentStart = p.tokenIndex
+	p.tokenIncrement = 0
+}
+
+func (p *urlPatternConstructorParser) rewind() {
+	p.tokenIndex = p.componentStart
+	p.tokenIncrement = 0
+}
+
+func (p *urlPatternConstructorParser) rewindAndSetState(state string) {
+	p.rewind()
+	p.state = state
+}
+
+func (p *urlPatternConstructorParser) safeToken(index int) urlPatternToken {
+	if index < len(p.tokens) {
+		return p.tokens[index]
+	}
+
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *urlPatternConstructorParser) isNonSpecialPatternChar(index int, value string) bool {
+	token := p.safeToken(index)
+	if token.value != value {
+		return false
+	}
+
+	return token.typ == urlPatternTokenChar || token.typ == urlPatternTokenEscapedChar || token.typ == urlPatternTokenInvalidChar
+}
+
+// isSearchPrefix reports whether the token starts the search. A "?" after a
+// group is a modifier instead.
+// https://urlpattern.spec.whatwg.org/#is-a-search-prefix
+func (p *urlPatternConstructorParser) isSearchPrefix() bool {
+	if p.isNonSpecialPatternChar(p.tokenIndex, "?") {
+		return true
+	}
+
+	if p.tokens[p.tokenIndex].value != "?" {
+		return false
+	}
+
+	if p.tokenIndex == 0 {
+		return true
+	}
+
+	switch p.safeToken(p.tokenIndex - 1).typ {
+	case urlPatternTokenName, urlPatternTokenRegexp, urlPatternTokenClose, urlPatternTokenAsterisk:
+		return false
+	}
+
+	return true
+}
+
+func (p *urlPatternConstructorParser) isHashPrefix() bool {
+	return p.isNonSpecialPatternChar(p.tokenIndex, "#")
+}
+
+func (p *urlPatternConstructorParser) makeComponentString() string {
+	start := p.safeToken(p.componentStart).index
+	end := p.tokens[p.tokenIndex].index
+
+	return string(p.input[start:end])
+}
+
+func (p *urlPatternConstructorParser) computeProtocolMatchesSpecialSchemeFlag() error {
+	protocol := p.makeComponentString()
+
+	component, err := compileURLPatternComponent(protocol, canonicalizeURLPatternProtocol, urlPatternParseOptions{})
+	if err != nil {
+		return fmt.Errorf("invalid protocol pattern %q: %w", protocol, err)
+	}
+
+	p.protocolMatchesSpecialFlag = component.matchesSpecialScheme()
+
+	return nil
+}
+
+// isIPv6HostnamePattern reports whether the hostname pattern is an IPv6
+// address.
+// https://urlpattern.spec.whatwg.org/#is-an-ipv6-address
+func isIPv6HostnamePattern(pattern string) bool {
+	runes := []rune(pattern)
+	if len(runes) < 2 {
+		return false
+	}
+
+	return runes[0] == '[' || (runes[0] == '{' || runes[0] == '\\') && runes[1] == '['
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-protocol
+func canonicalizeURLPatternProtocol(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	url, err := parseWHATWG(value+"://dummy.invalid/", nil)
+	if err != nil || url.scheme != strings.ToLower(value) {
+		return "", fmt.Errorf("invalid protocol %q", value)
+	}
+
+	return url.scheme, nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-username
+func canonicalizeURLPatternUsername(value string) (string, error) {
+	return percentEncodeString(value, inUserinfoPercentEncodeSet), nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-password
+func canonicalizeURLPatternPassword(value string) (string, error) {
+	return percentEncodeString(value, inUserinfoPercentEncodeSet), nil
+}
+
+// canonicalizeURLPatternHostname parses the hostname as an opaque host, like
+// the URL parser does for a URL with a scheme that is not special, so it's
+// matched as written.
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-hostname
+func canonicalizeURLPatternHostname(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	host, err := parseWHATWGOpaqueHost(value)
+	if err != nil {
+		return "", err
+	}
+
+	return host.value, nil
+}
+
+// canonicalizeURLPatternDomainName converts the hostname to ASCII and
+// lowercases it, like the host parser does for the domains of URLs with a
+// special scheme, so https://Bücher.example matches https://bücher.example.
+// https://url.spec.whatwg.org/#concept-domain-to-ascii
+func canonicalizeURLPatternDomainName(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	domain := percentDecode(value)
+	if !utf8.ValidString(domain) {
+		return "", fmt.Errorf("invalid hostname %q: not valid UTF-8", value)
+	}
+
+	return domainToASCII(domain)
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-an-ipv6-hostname
+func canonicalizeURLPatternIPv6Hostname(value string) (string, error) {
+	var b strings.Builder
+	for _, r := range value {
+		if r > unicode.MaxASCII || !isHexDigit(byte(r)) && !strings.ContainsRune("[]:", r) {
+			return "", fmt.Errorf("invalid IPv6 hostname %q", value)
+		}
+		b.WriteRune(toASCIILower(r))
+	}
+
+	return b.String(), nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-port
+func canonicalizeURLPatternPort(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return "", fmt.Errorf("invalid port %q", value)
+	}
+
+	return strconv.FormatUint(port, 10), nil
+}
+
+// canonicalizeURLPatternPathname parses the pathname like the path of a URL
+// with a hierarchical path, which resolves the dot segments and
+// percent-encodes it.
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-pathname
+func canonicalizeURLPatternPathname(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	leadingSlash := strings.HasPrefix(value, "/")
+	modified := value
+	if !leadingSlash {
+		modified = "/-" + value
+	}
+
+	// The path state override doesn't end the path at a "?" or a "#", which
+	// the path percent-encode set encodes.
+	modified = strings.NewReplacer("?", "%3F", "#", "%23").Replace(modified)
+
+	url, err := parseWHATWG("dummy://dummy.invalid"+modified, nil)
+	if err != nil {
+		return "", err
+	}
+
+	result := url.pathname()
+	if !leadingSlash {
+		result = strings.TrimPrefix(result, "/-")
+	}
+
+	return result, nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-an-opaque-pathname
+func canonicalizeURLPatternOpaquePathname(value string) (string, error) {
+	return percentEncodeString(value, inC0ControlPercentEncodeSet), nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-search
+func canonicalizeURLPatternSearch(value string) (string, error) {
+	return percentEncodeString(strings.TrimPrefix(value, "?"), inQueryPercentEncodeSet), nil
+}
+
+// https://urlpattern.spec.whatwg.org/#canonicalize-a-hash
+func canonicalizeURLPatternHash(value string) (string, error) {
+	return percentEncodeString(strings.TrimPrefix(value, "#"), inFragmentPercentEncodeSet), nil
+}
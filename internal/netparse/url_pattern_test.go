@@ -0,0 +1,215 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestURLPatternMatch(t *testing.T) {
+	group := func(s string) *string { return &s }
+
+	tests := []struct {
+		pattern string
+		options URLPatternOptions
+		input   string
+		matches bool
+		want    map[string]map[string]*string
+	}{
+		{
+			pattern: "https://*.example.com/api/:version/*",
+			input:   "https://www.example.com/api/v1/users/1?page=2",
+			matches: true,
+			want: map[string]map[string]*string{
+				"hostname": {"0": group("www")},
+				"pathname": {"version": group("v1"), "0": group("users/1")},
+				"search":   {"0": group("page=2")},
+			},
+		},
+		{
+			pattern: "https://*.example.com/api/:version/*",
+			input:   "https://example.com/api/v1/users",
+		},
+		{
+			pattern: "https://example.com/books/:id?",
+			input:   "https://example.com/books",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"id": nil},
+			},
+		},
+		{
+			pattern: "https://example.com/books/:id?",
+			input:   "https://example.com/books/12",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"id": group("12")},
+			},
+		},
+		{
+			pattern: `http{s}?://example.com/:id(\d+)`,
+			input:   "http://example.com/12",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"id": group("12")},
+			},
+		},
+		{
+			pattern: `http{s}?://example.com/:id(\d+)`,
+			input:   "https://example.com/ab",
+		},
+		{
+			pattern: "https://example.com/files/:path+",
+			input:   "https://example.com/files/a/b/c",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"path": group("a/b/c")},
+			},
+		},
+		{
+			pattern: "https://example.com/*",
+			input:   "https://example.com:8443/",
+		},
+		{
+			pattern: "https://example.com:443/*",
+			input:   "https://example.com/index.html",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"0": group("index.html")},
+			},
+		},
+		{
+			pattern: "https://example.com/a b/*",
+			input:   "https://example.com/a%20b/c",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"0": group("c")},
+			},
+		},
+		{
+			pattern: `https://[\:\:1]/*`,
+			input:   "https://[::1]/health",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"0": group("health")},
+			},
+		},
+		{
+			pattern: "mailto::user@:domain",
+			input:   "mailto:ops@example.com",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"user": group("ops"), "domain": group("example.com")},
+			},
+		},
+		{
+			pattern: "https://example.com/Docs/*",
+			input:   "https://example.com/docs/intro",
+		},
+		{
+			pattern: "https://example.com/Docs/*",
+			options: URLPatternOptions{IgnoreCase: true},
+			input:   "https://example.com/docs/intro",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"0": group("intro")},
+			},
+		},
+		{
+			pattern: "https://example.com/*",
+			input:   "not a URL",
+		},
+		{
+			pattern: "https://Example.com/*",
+			input:   "https://Example.com/x",
+			matches: true,
+			want: map[string]map[string]*string{
+				"pathname": {"0": group("x")},
+			},
+		},
+		{
+			pattern: "https://bücher.example/x",
+			input:   "https://bücher.example/x",
+			matches: true,
+		},
+		{
+			pattern: "https://*.Bücher.example/x",
+			input:   "https://www.xn--bcher-kva.example/x",
+			matches: true,
+			want: map[string]map[string]*string{
+				"hostname": {"0": group("www")},
+			},
+		},
+		{
+			pattern: "custom://Example.com/x",
+			input:   "custom://example.com/x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.input, func(t *testing.T) {
+			p, err := CompileURLPattern(tt.pattern, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			groups, matches := p.Match(tt.input)
+			if matches != tt.matches {
+				t.Fatalf("got matches %t, want %t", matches, tt.matches)
+			}
+
+			for component, want := range tt.want {
+				if !reflect.DeepEqual(groups[component], want) {
+					t.Errorf("got %s groups %v, want %v", component, groups[component], want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompileURLPatternComponents(t *testing.T) {
+	p, err := CompileURLPatternComponents(map[string]string{
+		"protocol": "http{s}?:",
+		"hostname": "{:subdomain.}?example.com",
+		"search":   "?q=*",
+	}, URLPatternOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	groups, matches := p.Match("https://api.example.com:8080/search?q=dns#results")
+	if !matches {
+		t.Fatal("expected a match")
+	}
+
+	if got := *groups["hostname"]["subdomain"]; got != "api" {
+		t.Errorf("got subdomain %q, want %q", got, "api")
+	}
+
+	if got := *groups["port"]["0"]; got != "8080" {
+		t.Errorf("got port %q, want %q", got, "8080")
+	}
+
+	if _, matches := p.Match("ftp://example.com/?q=dns"); matches {
+		t.Error("expected no match")
+	}
+}
+
+func TestCompileURLPatternError(t *testing.T) {
+	for _, pattern := range []string{
+		"/books/:id",
+		"https://example.com/:id/:id",
+		"https://example.com/:id(\\d+",
+		"https://example.com/(a(b))",
+		"https://example.com/:id((?<name>a))",
+		"https://example.com:99999/",
+		"ht tp://example.com/",
+		"https://example.com/:",
+		"https://example.com/:id((?!admin).*)",
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			if _, err := CompileURLPattern(pattern, URLPatternOptions{}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
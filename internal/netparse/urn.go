@@ -0,0 +1,149 @@
+package netparse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// URNModel describes a URN.
+type URNModel struct {
+	// NID is the namespace identifier, like uuid or ietf. It's lowercase.
+	NID string
+	// NSS is the namespace-specific string, as written in the URN.
+	NSS string
+	// RComponent is the resolution parameters, after ?+. It's empty when the
+	// URN has none.
+	RComponent string
+	// QComponent is the query parameters, after ?=. It's empty when the URN
+	// has none.
+	QComponent string
+	// FComponent is the fragment, after #. It's empty when the URN has none.
+	FComponent string
+	// Normalized is the assigned name, that is, urn:NID:NSS, normalized for
+	// comparison. Two URNs are equivalent when their normalized forms are
+	// equal.
+	Normalized string
+}
+
+// ParseURN parses a URN into its namespace identifier, namespace-specific
+// string, and r-, q- and f-components. The components are validated but not
+// percent-decoded. The normalized form lowercases the urn prefix and the
+// namespace identifier and uppercases the hex digits of percent-encodings, so
+// the r-, q- and f-components don't change the equivalence of URNs. The rules
+// of each namespace for equivalence, like case-insensitive UUIDs, are not
+// applied.
+// References used.
+// https://www.rfc-editor.org/rfc/rfc8141
+func ParseURN(u string) (*URNModel, error) {
+	if len(u) < len("urn:") || !strings.EqualFold(u[:len("urn:")], "urn:") {
+		return nil, fmt.Errorf("URN %q doesn't have the urn scheme", u)
+	}
+
+	rest, fComponent, hasFComponent := strings.Cut(u[len("urn:"):], "#")
+	if hasFComponent {
+		if err := validateURNComponent(fComponent, "/?"); err != nil {
+			return nil, fmt.Errorf("invalid f-component of URN %q: %w", u, err)
+		}
+	}
+
+	assignedName, qComponent, hasQComponent := strings.Cut(rest, "?=")
+	assignedName, rComponent, hasRComponent := strings.Cut(assignedName, "?+")
+
+	if hasRComponent && rComponent == "" {
+		return nil, fmt.Errorf("invalid r-component of URN %q: empty component", u)
+	}
+
+	if err := validateURNComponent(rComponent, "/?"); err != nil {
+		return nil, fmt.Errorf("invalid r-component of URN %q: %w", u, err)
+	}
+
+	if hasQComponent && qComponent == "" {
+		return nil, fmt.Errorf("invalid q-component of URN %q: empty component", u)
+	}
+
+	if err := validateURNComponent(qComponent, "/?"); err != nil {
+		return nil, fmt.Errorf("invalid q-component of URN %q: %w", u, err)
+	}
+
+	nid, nss, ok := strings.Cut(assignedName, ":")
+	if !ok {
+		return nil, fmt.Errorf("URN %q doesn't have a namespace-specific string", u)
+	}
+
+	if !isURNNID(nid) {
+		return nil, fmt.Errorf("invalid namespace identifier %q in URN %q", nid, u)
+	}
+
+	if nss == "" || nss[0] == '/' {
+		return nil, fmt.Errorf("invalid namespace-specific string %q in URN %q", nss, u)
+	}
+
+	if err := validateURNComponent(nss, "/"); err != nil {
+		return nil, fmt.Errorf("invalid namespace-specific string of URN %q: %w", u, err)
+	}
+
+	nid = strings.ToLower(nid)
+
+	return &URNModel{
+		NID:        nid,
+		NSS:        nss,
+		RComponent: rComponent,
+		QComponent: qComponent,
+		FComponent: fComponent,
+		Normalized: "urn:" + nid + ":" + upperPercentEncodings(nss),
+	}, nil
+}
+
+// isURNNID reports whether s is a namespace identifier: 2 to 32 letters,
+// digits and hyphens, starting and ending with a letter or digit.
+// https://www.rfc-editor.org/rfc/rfc8141#section-2
+func isURNNID(s string) bool {
+	if len(s) < 2 || len(s) > 32 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+
+	for _, r := range s {
+		if !isASCIIAlphanumeric(r) && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateURNComponent checks that s only has pchar characters, valid
+// percent-encodings and the extra characters.
+// https://www.rfc-editor.org/rfc/rfc3986#section-3.3
+func validateURNComponent(s string, extra string) error {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(s) || !isHexDigit(s[i+1]) || !isHexDigit(s[i+2]) {
+				return fmt.Errorf("invalid percent-encoding at offset %d", i)
+			}
+			i += 2
+		case isUnreserved(c), strings.IndexByte("!$&'()*+,;=:@", c) >= 0, strings.IndexByte(extra, c) >= 0:
+		default:
+			return fmt.Errorf("invalid character %q at offset %d", c, i)
+		}
+	}
+
+	return nil
+}
+
+// upperPercentEncodings uppercases the hex digits of the percent-encodings of
+// s.
+func upperPercentEncodings(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) {
+			percentEncodeByte(&b, unhex(s[i+1])<<4|unhex(s[i+2]))
+			i += 2
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+
+	return b.String()
+}
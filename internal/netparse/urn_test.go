@@ -0,0 +1,131 @@
+package netparse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseURN(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *URNModel
+	}{
+		{
+			input: "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66",
+			want: &URNModel{
+				NID:        "uuid",
+				NSS:        "6e8bc430-9c3a-11d9-9669-0800200c9a66",
+				Normalized: "urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66",
+			},
+		},
+		{
+			input: "URN:IETF:rfc:8141",
+			want: &URNModel{
+				NID:        "ietf",
+				NSS:        "rfc:8141",
+				Normalized: "urn:ietf:rfc:8141",
+			},
+		},
+		{
+			input: "urn:acme:team/a%2fb?+res=1?x?=q=2&lang=en#frag/?",
+			want: &URNModel{
+				NID:        "acme",
+				NSS:        "team/a%2fb",
+				RComponent: "res=1?x",
+				QComponent: "q=2&lang=en",
+				FComponent: "frag/?",
+				Normalized: "urn:acme:team/a%2Fb",
+			},
+		},
+		{
+			input: "urn:example:a?=b?+c",
+			want: &URNModel{
+				NID:        "example",
+				NSS:        "a",
+				QComponent: "b?+c",
+				Normalized: "urn:example:a",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseURN(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseURNEquivalence(t *testing.T) {
+	// Examples from https://www.rfc-editor.org/rfc/rfc8141#section-3.2
+	equivalent := []string{
+		"urn:example:a123,z456",
+		"URN:example:a123,z456",
+		"urn:EXAMPLE:a123,z456",
+		"urn:example:a123,z456?+abc",
+		"urn:example:a123,z456?=xyz",
+		"urn:example:a123,z456#789",
+	}
+	different := []string{
+		"urn:example:a123,z456/foo",
+		"urn:example:a123,z456/bar",
+		"urn:example:a123,z456/baz",
+		"urn:example:A123,z456",
+	}
+
+	first, err := ParseURN(equivalent[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, input := range equivalent[1:] {
+		if u, err := ParseURN(input); err != nil || u.Normalized != first.Normalized {
+			t.Errorf("%q is not equivalent to %q", input, equivalent[0])
+		}
+	}
+
+	for _, input := range different {
+		if u, err := ParseURN(input); err != nil || u.Normalized == first.Normalized {
+			t.Errorf("%q is equivalent to %q", input, equivalent[0])
+		}
+	}
+
+	a, _ := ParseURN("urn:example:%5b%5d")
+	b, _ := ParseURN("urn:example:%5B%5D")
+	if a.Normalized != b.Normalized {
+		t.Errorf("got %q and %q, want equal normalized forms", a.Normalized, b.Normalized)
+	}
+}
+
+func TestParseURNError(t *testing.T) {
+	for _, input := range []string{
+		"https://example.com",
+		"urn:",
+		"urn:example",
+		"urn:a:b",
+		"urn:-example:b",
+		"urn:example-:b",
+		"urn:exa_mple:b",
+		"urn:abcdefghijklmnopqrstuvwxyz0123456:b",
+		"urn:example:",
+		"urn:example:/a",
+		"urn:example:a b",
+		"urn:example:a?b",
+		"urn:example:a%4",
+		"urn:example:a?+",
+		"urn:example:a?=",
+		"urn:example:a#b c",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseURN(input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
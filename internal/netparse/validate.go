@@ -0,0 +1,143 @@
+package netparse
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	// URLRuleSyntax is violated by URLs that can't be parsed.
+	URLRuleSyntax = "syntax"
+	// URLRuleMaxLength is violated by URLs longer than URLPolicy.MaxLength.
+	URLRuleMaxLength = "max_length"
+	// URLRuleAllowedSchemes is violated by URLs whose scheme is not in
+	// URLPolicy.AllowedSchemes.
+	URLRuleAllowedSchemes = "allowed_schemes"
+	// URLRuleRequireTLS is violated by URLs whose scheme is not secured with
+	// TLS.
+	URLRuleRequireTLS = "require_tls"
+	// URLRuleForbidCredentials is violated by URLs with a username or password.
+	URLRuleForbidCredentials = "forbid_credentials"
+	// URLRuleForbidIPHosts is violated by URLs whose host is an IP address.
+	URLRuleForbidIPHosts = "forbid_ip_hosts"
+	// URLRuleAllowedHostSuffixes is violated by URLs whose host doesn't end
+	// with any of URLPolicy.AllowedHostSuffixes.
+	URLRuleAllowedHostSuffixes = "allowed_host_suffixes"
+	// URLRulePortRange is violated by URLs whose effective port is outside
+	// URLPolicy.MinPort and URLPolicy.MaxPort.
+	URLRulePortRange = "port_range"
+)
+
+// URLPolicy describes the rules that a URL must follow. The zero value allows
+// any URL that can be parsed.
+type URLPolicy struct {
+	// AllowedSchemes are the allowed schemes, compared case-insensitively. Any
+	// scheme is allowed when empty.
+	AllowedSchemes []string
+	// RequireTLS requires a scheme that is secured with TLS, like https.
+	RequireTLS bool
+	// ForbidCredentials forbids a username or password.
+	ForbidCredentials bool
+	// ForbidIPHosts forbids hosts that are IP addresses.
+	ForbidIPHosts bool
+	// AllowedHostSuffixes are the domains that the host must be equal to or a
+	// subdomain of. Any host is allowed when empty.
+	AllowedHostSuffixes []string
+	// MinPort and MaxPort bound the effective port. They are not checked when
+	// 0.
+	MinPort int
+	MaxPort int
+	// MaxLength is the maximum length of the URL in bytes. It's not checked
+	// when 0.
+	MaxLength int
+}
+
+// URLViolation describes a rule of a URLPolicy that a URL doesn't follow.
+type URLViolation struct {
+	// Rule is the name of the violated rule, like require_tls.
+	Rule    string
+	Message string
+}
+
+// ValidateURL checks the URL against the policy and returns the violations
+// of its rules. A URL that can't be parsed violates the syntax rule.
+func ValidateURL(u string, policy URLPolicy) []URLViolation {
+	var violations []URLViolation
+
+	if policy.MaxLength > 0 && len(u) > policy.MaxLength {
+		violations = append(violations, URLViolation{
+			Rule:    URLRuleMaxLength,
+			Message: fmt.Sprintf("URL is %d bytes long, the maximum is %d", len(u), policy.MaxLength),
+		})
+	}
+
+	urlModel, err := ParseURL(u)
+	if err != nil {
+		// The error of net/url quotes the URL, which can have a password.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+
+		return append(violations, URLViolation{
+			Rule:    URLRuleSyntax,
+			Message: "invalid URL: " + err.Error(),
+		})
+	}
+
+	return append(violations, urlModel.Validate(policy)...)
+}
+
+// Validate checks the parsed URL against the policy, except for the maximum
+// length, and returns the violations of its rules.
+func (u *URLModel) Validate(policy URLPolicy) []URLViolation {
+	var violations []URLViolation
+	violate := func(rule string, format string, a ...any) {
+		violations = append(violations, URLViolation{Rule: rule, Message: fmt.Sprintf(format, a...)})
+	}
+
+	if len(policy.AllowedSchemes) > 0 && !slices.ContainsFunc(policy.AllowedSchemes, func(s string) bool { return strings.EqualFold(s, u.Scheme) }) {
+		violate(URLRuleAllowedSchemes, "scheme %q is not one of: %s", u.Scheme, strings.Join(policy.AllowedSchemes, ", "))
+	}
+
+	if policy.RequireTLS && !IsTLSScheme(u.Scheme) {
+		violate(URLRuleRequireTLS, "scheme %q is not secured with TLS", u.Scheme)
+	}
+
+	if policy.ForbidCredentials && u.Credentials != "" {
+		violate(URLRuleForbidCredentials, "URL has credentials")
+	}
+
+	if policy.ForbidIPHosts && (u.HostType == HostTypeIPv4 || u.HostType == HostTypeIPv6) {
+		violate(URLRuleForbidIPHosts, "host %q is an IP address", u.Host)
+	}
+
+	if len(policy.AllowedHostSuffixes) > 0 && !slices.ContainsFunc(policy.AllowedHostSuffixes, func(suffix string) bool { return hasHostSuffix(u.Host, suffix) }) {
+		violate(URLRuleAllowedHostSuffixes, "host %q is not within: %s", u.Host, strings.Join(policy.AllowedHostSuffixes, ", "))
+	}
+
+	if policy.MinPort > 0 || policy.MaxPort > 0 {
+		switch {
+		case !u.HasEffectivePort:
+			violate(URLRulePortRange, "URL has no port and scheme %q has no default port", u.Scheme)
+		case policy.MinPort > 0 && u.EffectivePort < policy.MinPort:
+			violate(URLRulePortRange, "port %d is lower than %d", u.EffectivePort, policy.MinPort)
+		case policy.MaxPort > 0 && u.EffectivePort > policy.MaxPort:
+			violate(URLRulePortRange, "port %d is greater than %d", u.EffectivePort, policy.MaxPort)
+		}
+	}
+
+	return violations
+}
+
+// hasHostSuffix reports whether host is equal to the domain suffix or is one
+// of its subdomains. A leading dot in suffix is ignored.
+func hasHostSuffix(host string, suffix string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	suffix = strings.TrimPrefix(strings.ToLower(suffix), ".")
+
+	return host != "" && (host == suffix || strings.HasSuffix(host, "."+suffix))
+}
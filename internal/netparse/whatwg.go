@@ -0,0 +1,759 @@
+package netparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// specialSchemes maps each special scheme to its default port, or -1 when the
+// scheme has no default port.
+// https://url.spec.whatwg.org/#special-scheme
+var specialSchemes = map[string]int{
+	"ftp":   21,
+	"file":  -1,
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+}
+
+// whatwgURL describes a URL record as defined by the WHATWG URL Standard.
+// References used.
+// https://url.spec.whatwg.org/#concept-url
+type whatwgURL struct {
+	scheme   string
+	username string
+	password string
+	// host is nil when the URL has no host.
+	host *whatwgHost
+	// port is -1 when the URL has no port.
+	port int
+	// path is used when the URL does not have an opaque path.
+	path []string
+	// opaquePath is used when hasOpaquePath is true.
+	opaquePath    string
+	hasOpaquePath bool
+	query         *string
+	fragment      *string
+}
+
+type whatwgState int
+
+const (
+	schemeStartState whatwgState = iota
+	schemeState
+	noSchemeState
+	specialRelativeOrAuthorityState
+	pathOrAuthorityState
+	relativeState
+	relativeSlashState
+	specialAuthoritySlashesState
+	specialAuthorityIgnoreSlashesState
+	authorityState
+	hostState
+	portState
+	fileState
+	fileSlashState
+	fileHostState
+	pathStartState
+	pathState
+	opaquePathState
+	queryState
+	fragmentState
+)
+
+// eof is the code point returned past the end of the input.
+const eof rune = -1
+
+// ParseWHATWGURL parses a URL following the WHATWG URL Standard, the way
+// browsers and the Node.js URL class do.
+func ParseWHATWGURL(u string) (*URLModel, error) {
+	internalURL, err := parseWHATWG(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	username := internalURL.username
+	password := internalURL.password
+	credentials := username
+	if password != "" {
+		credentials += ":" + password
+	}
+
+	host := internalURL.hostname()
+	hostType, domain := classifyWHATWGHost(internalURL.host)
+	port := internalURL.portString()
+	effectivePort, isDefaultPort := effectivePort(internalURL.scheme, port)
+	authority := host
+	if credentials != "" {
+		authority = credentials + "@" + authority
+	}
+	if port != "" {
+		authority += ":" + port
+	}
+
+	var query string
+	if internalURL.query != nil {
+		query = *internalURL.query
+	}
+
+	var fragment string
+	if internalURL.fragment != nil {
+		fragment = *internalURL.fragment
+	}
+
+	var opaque string
+	if internalURL.hasOpaquePath {
+		opaque = internalURL.opaquePath
+	}
+
+	pathSegments := []string{}
+	for _, segment := range internalURL.path {
+		pathSegments = append(pathSegments, percentDecode(segment))
+	}
+
+	var origin string
+	if serialized := internalURL.origin(); serialized != "null" {
+		origin = serialized
+	}
+
+	return &URLModel{
+		Url:              u,
+		Href:             internalURL.href(),
+		Authority:        authority,
+		Protocol:         internalURL.protocol(),
+		Scheme:           internalURL.scheme,
+		Credentials:      credentials,
+		Username:         username,
+		Password:         password,
+		Host:             host,
+		HostType:         hostType,
+		Domain:           domain,
+		Port:             port,
+		EffectivePort:    effectivePort,
+		HasEffectivePort: port != "" || isDefaultPort,
+		IsDefaultPort:    isDefaultPort,
+		Path:             internalURL.pathname(),
+		Search:           internalURL.search(),
+		Query:            query,
+		Hash:             internalURL.hash(),
+		Fragment:         fragment,
+		Origin:           origin,
+		Opaque:           opaque,
+		RawPath:          internalURL.pathname(),
+		PathSegments:     pathSegments,
+		ForceQuery:       internalURL.query != nil && *internalURL.query == "",
+		OmitHost:         internalURL.host == nil && strings.HasPrefix(internalURL.pathname(), "/"),
+		RawFragment:      fragment,
+	}, nil
+}
+
+// parseWHATWG implements the basic URL parser without state override.
+// https://url.spec.whatwg.org/#concept-basic-url-parser
+func parseWHATWG(rawInput string, base *whatwgURL) (*whatwgURL, error) {
+	url := &whatwgURL{port: -1}
+
+	rawInput = strings.TrimFunc(rawInput, func(r rune) bool { return r <= 0x20 })
+	rawInput = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(rawInput)
+	input := []rune(rawInput)
+
+	state := schemeStartState
+	var buffer strings.Builder
+	atSignSeen, insideBrackets, passwordTokenSeen := false, false, false
+
+	fail := func(format string, a ...any) (*whatwgURL, error) {
+		return nil, fmt.Errorf("invalid URL %q: %s", rawInput, fmt.Sprintf(format, a...))
+	}
+
+	for pointer := 0; ; pointer++ {
+		c := eof
+		if pointer < len(input) {
+			c = input[pointer]
+		}
+		remaining := func(offset int) rune {
+			if i := pointer + offset; i >= 0 && i < len(input) {
+				return input[i]
+			}
+			return eof
+		}
+
+		switch state {
+		case schemeStartState:
+			if isASCIIAlpha(c) {
+				buffer.WriteRune(toASCIILower(c))
+				state = schemeState
+			} else {
+				state = noSchemeState
+				pointer--
+			}
+
+		case schemeState:
+			if isASCIIAlphanumeric(c) || c == '+' || c == '-' || c == '.' {
+				buffer.WriteRune(toASCIILower(c))
+			} else if c == ':' {
+				url.scheme = buffer.String()
+				buffer.Reset()
+
+				if url.scheme == "file" {
+					state = fileState
+				} else if url.isSpecial() && base != nil && base.scheme == url.scheme {
+					state = specialRelativeOrAuthorityState
+				} else if url.isSpecial() {
+					state = specialAuthoritySlashesState
+				} else if remaining(1) == '/' {
+					state = pathOrAuthorityState
+					pointer++
+				} else {
+					url.hasOpaquePath = true
+					state = opaquePathState
+				}
+			} else {
+				buffer.Reset()
+				state = noSchemeState
+				pointer = -1
+			}
+
+		case noSchemeState:
+			if base == nil || (base.hasOpaquePath && c != '#') {
+				return fail("missing scheme")
+			} else if base.hasOpaquePath && c == '#' {
+				url.scheme = base.scheme
+				url.opaquePath = base.opaquePath
+				url.hasOpaquePath = true
+				url.query = cloneString(base.query)
+				url.fragment = new(string)
+				state = fragmentState
+			} else if base.scheme != "file" {
+				state = relativeState
+				pointer--
+			} else {
+				state = fileState
+				pointer--
+			}
+
+		case specialRelativeOrAuthorityState:
+			if c == '/' && remaining(1) == '/' {
+				state = specialAuthorityIgnoreSlashesState
+				pointer++
+			} else {
+				state = relativeState
+				pointer--
+			}
+
+		case pathOrAuthorityState:
+			if c == '/' {
+				state = authorityState
+			} else {
+				state = pathState
+				pointer--
+			}
+
+		case relativeState:
+			url.scheme = base.scheme
+			if c == '/' || (url.isSpecial() && c == '\\') {
+				state = relativeSlashState
+			} else {
+				url.username = base.username
+				url.password = base.password
+				url.host = base.host
+				url.port = base.port
+				url.path = append([]string(nil), base.path...)
+				url.query = cloneString(base.query)
+
+				if c == '?' {
+					url.query = new(string)
+					state = queryState
+				} else if c == '#' {
+					url.fragment = new(string)
+					state = fragmentState
+				} else if c != eof {
+					url.query = nil
+					url.shortenPath()
+					state = pathState
+					pointer--
+				}
+			}
+
+		case relativeSlashState:
+			if url.isSpecial() && (c == '/' || c == '\\') {
+				state = specialAuthorityIgnoreSlashesState
+			} else if c == '/' {
+				state = authorityState
+			} else {
+				url.username = base.username
+				url.password = base.password
+				url.host = base.host
+				url.port = base.port
+				state = pathState
+				pointer--
+			}
+
+		case specialAuthoritySlashesState:
+			if c == '/' && remaining(1) == '/' {
+				pointer++
+			} else {
+				pointer--
+			}
+			state = specialAuthorityIgnoreSlashesState
+
+		case specialAuthorityIgnoreSlashesState:
+			if c != '/' && c != '\\' {
+				state = authorityState
+				pointer--
+			}
+
+		case authorityState:
+			if c == '@' {
+				credentials := buffer.String()
+				if atSignSeen {
+					credentials = "%40" + credentials
+				}
+				atSignSeen = true
+
+				var username, password strings.Builder
+				username.WriteString(url.username)
+				password.WriteString(url.password)
+				for _, r := range credentials {
+					if r == ':' && !passwordTokenSeen {
+						passwordTokenSeen = true
+						continue
+					}
+
+					if passwordTokenSeen {
+						percentEncodeRune(&password, r, inUserinfoPercentEncodeSet)
+					} else {
+						percentEncodeRune(&username, r, inUserinfoPercentEncodeSet)
+					}
+				}
+				url.username = username.String()
+				url.password = password.String()
+				buffer.Reset()
+			} else if c == eof || c == '/' || c == '?' || c == '#' || (url.isSpecial() && c == '\\') {
+				if atSignSeen && buffer.Len() == 0 {
+					return fail("missing host")
+				}
+				pointer -= len([]rune(buffer.String())) + 1
+				buffer.Reset()
+				state = hostState
+			} else {
+				buffer.WriteRune(c)
+			}
+
+		case hostState:
+			if c == ':' && !insideBrackets {
+				if buffer.Len() == 0 {
+					return fail("missing host")
+				}
+
+				host, err := parseWHATWGHost(buffer.String(), !url.isSpecial())
+				if err != nil {
+					return nil, err
+				}
+				url.host = host
+				buffer.Reset()
+				state = portState
+			} else if c == eof || c == '/' || c == '?' || c == '#' || (url.isSpecial() && c == '\\') {
+				pointer--
+				if url.isSpecial() && buffer.Len() == 0 {
+					return fail("missing host")
+				}
+
+				host, err := parseWHATWGHost(buffer.String(), !url.isSpecial())
+				if err != nil {
+					return nil, err
+				}
+				url.host = host
+				buffer.Reset()
+				state = pathStartState
+			} else {
+				if c == '[' {
+					insideBrackets = true
+				} else if c == ']' {
+					insideBrackets = false
+				}
+				buffer.WriteRune(c)
+			}
+
+		case portState:
+			if '0' <= c && c <= '9' {
+				buffer.WriteRune(c)
+			} else if c == eof || c == '/' || c == '?' || c == '#' || (url.isSpecial() && c == '\\') {
+				if buffer.Len() != 0 {
+					port, err := strconv.Atoi(buffer.String())
+					if err != nil || port > 65535 {
+						return fail("port %s out of range", buffer.String())
+					}
+
+					if defaultPort, ok := specialSchemes[url.scheme]; ok && defaultPort == port {
+						url.port = -1
+					} else {
+						url.port = port
+					}
+					buffer.Reset()
+				}
+				state = pathStartState
+				pointer--
+			} else {
+				return fail("invalid port")
+			}
+
+		case fileState:
+			url.scheme = "file"
+			url.host = &whatwgHost{kind: whatwgHostEmpty}
+
+			if c == '/' || c == '\\' {
+				state = fileSlashState
+			} else if base != nil && base.scheme == "file" {
+				url.host = base.host
+				url.path = append([]string(nil), base.path...)
+				url.query = cloneString(base.query)
+
+				if c == '?' {
+					url.query = new(string)
+					state = queryState
+				} else if c == '#' {
+					url.fragment = new(string)
+					state = fragmentState
+				} else if c != eof {
+					url.query = nil
+					if !startsWithWindowsDriveLetter(input[pointer:]) {
+						url.shortenPath()
+					} else {
+						url.path = nil
+					}
+					state = pathState
+					pointer--
+				}
+			} else {
+				state = pathState
+				pointer--
+			}
+
+		case fileSlashState:
+			if c == '/' || c == '\\' {
+				state = fileHostState
+			} else {
+				if base != nil && base.scheme == "file" {
+					url.host = base.host
+					if !startsWithWindowsDriveLetter(input[pointer:]) && len(base.path) > 0 && isNormalizedWindowsDriveLetter(base.path[0]) {
+						url.path = append(url.path, base.path[0])
+					}
+				}
+				state = pathState
+				pointer--
+			}
+
+		case fileHostState:
+			if c == eof || c == '/' || c == '\\' || c == '?' || c == '#' {
+				pointer--
+				if isWindowsDriveLetter(buffer.String()) {
+					// The buffer is not reset here and is used in the path state instead.
+					state = pathState
+				} else if buffer.Len() == 0 {
+					url.host = &whatwgHost{kind: whatwgHostEmpty}
+					state = pathStartState
+				} else {
+					host, err := parseWHATWGHost(buffer.String(), !url.isSpecial())
+					if err != nil {
+						return nil, err
+					}
+					if host.value == "localhost" {
+						host = &whatwgHost{kind: whatwgHostEmpty}
+					}
+					url.host = host
+					buffer.Reset()
+					state = pathStartState
+				}
+			} else {
+				buffer.WriteRune(c)
+			}
+
+		case pathStartState:
+			if url.isSpecial() {
+				state = pathState
+				if c != '/' && c != '\\' {
+					pointer--
+				}
+			} else if c == '?' {
+				url.query = new(string)
+				state = queryState
+			} else if c == '#' {
+				url.fragment = new(string)
+				state = fragmentState
+			} else if c != eof {
+				state = pathState
+				if c != '/' {
+					pointer--
+				}
+			}
+
+		case pathState:
+			isSlash := c == '/' || (url.isSpecial() && c == '\\')
+			if c == eof || isSlash || c == '?' || c == '#' {
+				segment := buffer.String()
+				if isDoubleDotPathSegment(segment) {
+					url.shortenPath()
+					if !isSlash {
+						url.path = append(url.path, "")
+					}
+				} else if isSingleDotPathSegment(segment) && !isSlash {
+					url.path = append(url.path, "")
+				} else if !isSingleDotPathSegment(segment) {
+					if url.scheme == "file" && len(url.path) == 0 && isWindowsDriveLetter(segment) {
+						segment = segment[:1] + ":"
+					}
+					url.path = append(url.path, segment)
+				}
+				buffer.Reset()
+
+				if c == '?' {
+					url.query = new(string)
+					state = queryState
+				} else if c == '#' {
+					url.fragment = new(string)
+					state = fragmentState
+				}
+			} else {
+				percentEncodeRune(&buffer, c, inPathPercentEncodeSet)
+			}
+
+		case opaquePathState:
+			if c == '?' {
+				url.query = new(string)
+				state = queryState
+			} else if c == '#' {
+				url.fragment = new(string)
+				state = fragmentState
+			} else if c != eof {
+				var b strings.Builder
+				percentEncodeRune(&b, c, inC0ControlPercentEncodeSet)
+				url.opaquePath += b.String()
+			}
+
+		case queryState:
+			if c == eof || c == '#' {
+				set := inQueryPercentEncodeSet
+				if url.isSpecial() {
+					set = inSpecialQueryPercentEncodeSet
+				}
+				query := *url.query + percentEncodeString(buffer.String(), set)
+				url.query = &query
+				buffer.Reset()
+
+				if c == '#' {
+					url.fragment = new(string)
+					state = fragmentState
+				}
+			} else {
+				buffer.WriteRune(c)
+			}
+
+		case fragmentState:
+			if c != eof {
+				var b strings.Builder
+				percentEncodeRune(&b, c, inFragmentPercentEncodeSet)
+				fragment := *url.fragment + b.String()
+				url.fragment = &fragment
+			}
+		}
+
+		if pointer >= len(input) {
+			break
+		}
+	}
+
+	return url, nil
+}
+
+func (u *whatwgURL) isSpecial() bool {
+	return isSpecialScheme(u.scheme)
+}
+
+// shortenPath implements shorten a URL's path.
+// https://url.spec.whatwg.org/#shorten-a-urls-path
+func (u *whatwgURL) shortenPath() {
+	if u.scheme == "file" && len(u.path) == 1 && isNormalizedWindowsDriveLetter(u.path[0]) {
+		return
+	}
+
+	if len(u.path) > 0 {
+		u.path = u.path[:len(u.path)-1]
+	}
+}
+
+// href implements the URL serializer.
+// https://url.spec.whatwg.org/#concept-url-serializer
+func (u *whatwgURL) href() string {
+	var b strings.Builder
+	b.WriteString(u.scheme + ":")
+
+	if u.host != nil {
+		b.WriteString("//")
+		if u.username != "" || u.password != "" {
+			b.WriteString(u.username)
+			if u.password != "" {
+				b.WriteString(":" + u.password)
+			}
+			b.WriteString("@")
+		}
+		b.WriteString(u.host.value)
+		if u.port != -1 {
+			b.WriteString(":" + strconv.Itoa(u.port))
+		}
+	}
+
+	if u.host == nil && !u.hasOpaquePath && len(u.path) > 1 && u.path[0] == "" {
+		b.WriteString("/.")
+	}
+
+	b.WriteString(u.pathname())
+	if u.query != nil {
+		b.WriteString("?" + *u.query)
+	}
+	if u.fragment != nil {
+		b.WriteString("#" + *u.fragment)
+	}
+
+	return b.String()
+}
+
+// origin implements the ASCII serialization of the URL origin, which is
+// "null" for opaque origins.
+// https://url.spec.whatwg.org/#concept-url-origin
+func (u *whatwgURL) origin() string {
+	switch u.scheme {
+	case "blob":
+		pathURL, err := parseWHATWG(u.pathname(), nil)
+		if err == nil && (pathURL.scheme == "http" || pathURL.scheme == "https") {
+			return pathURL.origin()
+		}
+	case "ftp", "http", "https", "ws", "wss":
+		origin := u.scheme + "://" + u.host.value
+		if u.port != -1 {
+			origin += ":" + strconv.Itoa(u.port)
+		}
+
+		return origin
+	}
+
+	return "null"
+}
+
+func (u *whatwgURL) protocol() string {
+	return u.scheme + ":"
+}
+
+func (u *whatwgURL) hostname() string {
+	if u.host == nil {
+		return ""
+	}
+
+	return u.host.value
+}
+
+func (u *whatwgURL) portString() string {
+	if u.port == -1 {
+		return ""
+	}
+
+	return strconv.Itoa(u.port)
+}
+
+func (u *whatwgURL) hostWithPort() string {
+	if u.port == -1 {
+		return u.hostname()
+	}
+
+	return u.hostname() + ":" + u.portString()
+}
+
+// pathname implements the URL path serializer.
+// https://url.spec.whatwg.org/#url-path-serializer
+func (u *whatwgURL) pathname() string {
+	if u.hasOpaquePath {
+		return u.opaquePath
+	}
+
+	var b strings.Builder
+	for _, segment := range u.path {
+		b.WriteString("/" + segment)
+	}
+
+	return b.String()
+}
+
+func (u *whatwgURL) search() string {
+	if u.query == nil || *u.query == "" {
+		return ""
+	}
+
+	return "?" + *u.query
+}
+
+func (u *whatwgURL) hash() string {
+	if u.fragment == nil || *u.fragment == "" {
+		return ""
+	}
+
+	return "#" + *u.fragment
+}
+
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	c := *s
+
+	return &c
+}
+
+func isASCIIAlpha(r rune) bool {
+	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z'
+}
+
+func isASCIIAlphanumeric(r rune) bool {
+	return isASCIIAlpha(r) || '0' <= r && r <= '9'
+}
+
+func toASCIILower(r rune) rune {
+	if 'A' <= r && r <= 'Z' {
+		return r + ('a' - 'A')
+	}
+
+	return r
+}
+
+// isWindowsDriveLetter reports whether s is a Windows drive letter.
+// https://url.spec.whatwg.org/#windows-drive-letter
+func isWindowsDriveLetter(s string) bool {
+	return len(s) == 2 && isASCIIAlpha(rune(s[0])) && (s[1] == ':' || s[1] == '|')
+}
+
+func isNormalizedWindowsDriveLetter(s string) bool {
+	return isWindowsDriveLetter(s) && s[1] == ':'
+}
+
+// startsWithWindowsDriveLetter reports whether the code points start with a
+// Windows drive letter.
+// https://url.spec.whatwg.org/#start-with-a-windows-drive-letter
+func startsWithWindowsDriveLetter(input []rune) bool {
+	if len(input) < 2 || !isWindowsDriveLetter(string(input[:2])) {
+		return false
+	}
+
+	return len(input) == 2 || strings.ContainsRune("/\\?#", input[2])
+}
+
+func isSingleDotPathSegment(s string) bool {
+	return s == "." || strings.EqualFold(s, "%2e")
+}
+
+func isDoubleDotPathSegment(s string) bool {
+	switch strings.ToLower(s) {
+	case "..", ".%2e", "%2e.", "%2e%2e":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,453 @@
+package netparse
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// whatwgHostKind identifies the kind of a host parsed by the WHATWG host parser.
+type whatwgHostKind int
+
+const (
+	whatwgHostDomain whatwgHostKind = iota
+	whatwgHostIPv4
+	whatwgHostIPv6
+	whatwgHostOpaque
+	whatwgHostEmpty
+)
+
+// whatwgHost describes a host as defined by the WHATWG URL Standard.
+// References used.
+// https://url.spec.whatwg.org/#hosts-(domains-and-ip-addresses)
+type whatwgHost struct {
+	kind whatwgHostKind
+	// value is the serialized host. IPv6 addresses are enclosed in brackets.
+	value string
+}
+
+// whatwgIDNA implements the UTS #46 processing used by domain to ASCII.
+// https://url.spec.whatwg.org/#concept-domain-to-ascii
+var whatwgIDNA = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.Transitional(false),
+	idna.CheckHyphens(false),
+	idna.CheckJoiners(true),
+	idna.StrictDomainName(false),
+	idna.VerifyDNSLength(false),
+)
+
+const (
+	forbiddenHostCodePoints   = "\x00\t\n\r #/:<>?@[\\]^|"
+	forbiddenDomainCodePoints = forbiddenHostCodePoints + "%\x7f"
+)
+
+func isForbiddenHostCodePoint(r rune) bool {
+	return strings.ContainsRune(forbiddenHostCodePoints, r)
+}
+
+func isForbiddenDomainCodePoint(r rune) bool {
+	return r < 0x20 || strings.ContainsRune(forbiddenDomainCodePoints, r)
+}
+
+// parseWHATWGHost implements the host parser.
+// https://url.spec.whatwg.org/#concept-host-parser
+func parseWHATWGHost(input string, isOpaque bool) (*whatwgHost, error) {
+	if strings.HasPrefix(input, "[") {
+		if !strings.HasSuffix(input, "]") {
+			return nil, fmt.Errorf("invalid IPv6 address %q: unclosed bracket", input)
+		}
+
+		address, err := parseWHATWGIPv6(input[1 : len(input)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		return &whatwgHost{kind: whatwgHostIPv6, value: "[" + serializeIPv6(address) + "]"}, nil
+	}
+
+	if isOpaque {
+		return parseWHATWGOpaqueHost(input)
+	}
+
+	domain := percentDecode(input)
+	if !utf8.ValidString(domain) {
+		domain = strings.ToValidUTF8(domain, "�")
+	}
+
+	asciiDomain, err := domainToASCII(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	if whatwgEndsInANumber(asciiDomain) {
+		address, err := parseWHATWGIPv4(asciiDomain)
+		if err != nil {
+			return nil, err
+		}
+
+		return &whatwgHost{kind: whatwgHostIPv4, value: serializeIPv4(address)}, nil
+	}
+
+	return &whatwgHost{kind: whatwgHostDomain, value: asciiDomain}, nil
+}
+
+// domainToASCII implements domain to ASCII with beStrict set to false.
+// https://url.spec.whatwg.org/#concept-domain-to-ascii
+func domainToASCII(domain string) (string, error) {
+	var result string
+	if isASCII(domain) && !hasPunycodeLabel(domain) {
+		result = strings.ToLower(domain)
+	} else {
+		var err error
+		result, err = whatwgIDNA.ToASCII(domain)
+		if err != nil {
+			return "", fmt.Errorf("invalid domain %q: %w", domain, err)
+		}
+	}
+
+	if result == "" {
+		return "", fmt.Errorf("invalid domain %q: empty host", domain)
+	}
+
+	if i := strings.IndexFunc(result, isForbiddenDomainCodePoint); i >= 0 {
+		return "", fmt.Errorf("invalid domain %q: forbidden code point %q", domain, result[i])
+	}
+
+	return result, nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasPunycodeLabel(domain string) bool {
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) >= 4 && strings.EqualFold(label[:4], "xn--") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseWHATWGOpaqueHost implements the opaque-host parser.
+// https://url.spec.whatwg.org/#concept-opaque-host-parser
+func parseWHATWGOpaqueHost(input string) (*whatwgHost, error) {
+	if input == "" {
+		return &whatwgHost{kind: whatwgHostEmpty}, nil
+	}
+
+	if i := strings.IndexFunc(input, isForbiddenHostCodePoint); i >= 0 {
+		return nil, fmt.Errorf("invalid host %q: forbidden code point %q", input, input[i])
+	}
+
+	return &whatwgHost{kind: whatwgHostOpaque, value: percentEncodeString(input, inC0ControlPercentEncodeSet)}, nil
+}
+
+// whatwgEndsInANumber implements the ends in a number checker.
+// https://url.spec.whatwg.org/#ends-in-a-number-checker
+func whatwgEndsInANumber(input string) bool {
+	parts := strings.Split(input, ".")
+	if parts[len(parts)-1] == "" {
+		if len(parts) == 1 {
+			return false
+		}
+		parts = parts[:len(parts)-1]
+	}
+
+	last := parts[len(parts)-1]
+	if last != "" && strings.Trim(last, "0123456789") == "" {
+		return true
+	}
+
+	_, err := parseWHATWGIPv4Number(last)
+
+	return err == nil
+}
+
+var errInvalidIPv4Number = errors.New("invalid IPv4 number")
+
+// parseWHATWGIPv4Number implements the IPv4 number parser. Values that do not
+// fit in 33 bits saturate, since any of them is rejected by the IPv4 parser.
+// https://url.spec.whatwg.org/#ipv4-number-parser
+func parseWHATWGIPv4Number(input string) (uint64, error) {
+	if input == "" {
+		return 0, errInvalidIPv4Number
+	}
+
+	radix := uint64(10)
+	if len(input) >= 2 && (input[:2] == "0x" || input[:2] == "0X") {
+		input = input[2:]
+		radix = 16
+	} else if len(input) >= 2 && input[0] == '0' {
+		input = input[1:]
+		radix = 8
+	}
+
+	var n uint64
+	for i := 0; i < len(input); i++ {
+		var digit uint64
+		c := input[i]
+		switch {
+		case '0' <= c && c <= '9':
+			digit = uint64(c - '0')
+		case 'a' <= c && c <= 'f':
+			digit = uint64(c-'a') + 10
+		case 'A' <= c && c <= 'F':
+			digit = uint64(c-'A') + 10
+		default:
+			return 0, errInvalidIPv4Number
+		}
+		if digit >= radix {
+			return 0, errInvalidIPv4Number
+		}
+
+		if n < 1<<33 {
+			n = n*radix + digit
+		}
+	}
+
+	return n, nil
+}
+
+// parseWHATWGIPv4 implements the IPv4 parser.
+// https://url.spec.whatwg.org/#concept-ipv4-parser
+func parseWHATWGIPv4(input string) (uint32, error) {
+	parts := strings.Split(input, ".")
+	if parts[len(parts)-1] == "" && len(parts) > 1 {
+		parts = parts[:len(parts)-1]
+	}
+
+	if len(parts) > 4 {
+		return 0, fmt.Errorf("invalid IPv4 address %q: too many parts", input)
+	}
+
+	numbers := make([]uint64, 0, len(parts))
+	for _, part := range parts {
+		n, err := parseWHATWGIPv4Number(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid IPv4 address %q: %w", input, err)
+		}
+		numbers = append(numbers, n)
+	}
+
+	last := numbers[len(numbers)-1]
+	for _, n := range numbers[:len(numbers)-1] {
+		if n > 255 {
+			return 0, fmt.Errorf("invalid IPv4 address %q: part out of range", input)
+		}
+	}
+
+	if last >= 1<<(8*(5-len(numbers))) {
+		return 0, fmt.Errorf("invalid IPv4 address %q: part out of range", input)
+	}
+
+	ipv4 := last
+	for i, n := range numbers[:len(numbers)-1] {
+		ipv4 += n << (8 * (3 - i))
+	}
+
+	return uint32(ipv4), nil
+}
+
+func serializeIPv4(address uint32) string {
+	return strconv.Itoa(int(address>>24)) + "." +
+		strconv.Itoa(int(address>>16&0xFF)) + "." +
+		strconv.Itoa(int(address>>8&0xFF)) + "." +
+		strconv.Itoa(int(address&0xFF))
+}
+
+// parseWHATWGIPv6 implements the IPv6 parser.
+// https://url.spec.whatwg.org/#concept-ipv6-parser
+func parseWHATWGIPv6(input string) ([8]uint16, error) {
+	var address [8]uint16
+	invalid := func(reason string) ([8]uint16, error) {
+		return [8]uint16{}, fmt.Errorf("invalid IPv6 address %q: %s", input, reason)
+	}
+
+	pieceIndex := 0
+	compress := -1
+	pointer := 0
+	at := func(i int) byte {
+		if i < len(input) {
+			return input[i]
+		}
+		return 0
+	}
+
+	if at(pointer) == ':' {
+		if at(pointer+1) != ':' {
+			return invalid("unexpected leading colon")
+		}
+		pointer += 2
+		pieceIndex++
+		compress = pieceIndex
+	}
+
+	for pointer < len(input) {
+		if pieceIndex == 8 {
+			return invalid("too many pieces")
+		}
+
+		if input[pointer] == ':' {
+			if compress != -1 {
+				return invalid("multiple compressions")
+			}
+			pointer++
+			pieceIndex++
+			compress = pieceIndex
+			continue
+		}
+
+		value, length := 0, 0
+		for length < 4 && pointer < len(input) && isHexDigit(input[pointer]) {
+			value = value*0x10 + int(unhex(input[pointer]))
+			pointer++
+			length++
+		}
+
+		if at(pointer) == '.' {
+			if length == 0 {
+				return invalid("empty IPv4 part")
+			}
+			pointer -= length
+
+			if pieceIndex > 6 {
+				return invalid("too many pieces with embedded IPv4 address")
+			}
+
+			numbersSeen := 0
+			for pointer < len(input) {
+				ipv4Piece := -1
+				if numbersSeen > 0 {
+					if input[pointer] == '.' && numbersSeen < 4 {
+						pointer++
+					} else {
+						return invalid("invalid embedded IPv4 address")
+					}
+				}
+
+				if !isASCIIDigit(at(pointer)) {
+					return invalid("invalid embedded IPv4 address")
+				}
+
+				for isASCIIDigit(at(pointer)) {
+					number := int(input[pointer] - '0')
+					switch ipv4Piece {
+					case -1:
+						ipv4Piece = number
+					case 0:
+						return invalid("invalid embedded IPv4 address")
+					default:
+						ipv4Piece = ipv4Piece*10 + number
+					}
+
+					if ipv4Piece > 255 {
+						return invalid("embedded IPv4 part out of range")
+					}
+					pointer++
+				}
+
+				address[pieceIndex] = address[pieceIndex]*0x100 + uint16(ipv4Piece)
+				numbersSeen++
+				if numbersSeen == 2 || numbersSeen == 4 {
+					pieceIndex++
+				}
+			}
+
+			if numbersSeen != 4 {
+				return invalid("too few embedded IPv4 parts")
+			}
+
+			break
+		} else if at(pointer) == ':' {
+			pointer++
+			if pointer >= len(input) {
+				return invalid("unexpected trailing colon")
+			}
+		} else if pointer < len(input) {
+			return invalid("unexpected code point")
+		}
+
+		address[pieceIndex] = uint16(value)
+		pieceIndex++
+	}
+
+	if compress != -1 {
+		swaps := pieceIndex - compress
+		pieceIndex = 7
+		for pieceIndex != 0 && swaps > 0 {
+			address[pieceIndex], address[compress+swaps-1] = address[compress+swaps-1], address[pieceIndex]
+			pieceIndex--
+			swaps--
+		}
+	} else if pieceIndex != 8 {
+		return invalid("too few pieces")
+	}
+
+	return address, nil
+}
+
+// serializeIPv6 implements the IPv6 serializer, without the enclosing
+// brackets.
+// https://url.spec.whatwg.org/#concept-ipv6-serializer
+func serializeIPv6(address [8]uint16) string {
+	compress, longest := -1, 1
+	for i := 0; i < 8; {
+		if address[i] != 0 {
+			i++
+			continue
+		}
+
+		j := i
+		for j < 8 && address[j] == 0 {
+			j++
+		}
+		if j-i > longest {
+			compress, longest = i, j-i
+		}
+		i = j
+	}
+
+	var b strings.Builder
+	ignore0 := false
+	for i, piece := range address {
+		if ignore0 && piece == 0 {
+			continue
+		}
+		ignore0 = false
+
+		if compress == i {
+			if i == 0 {
+				b.WriteString("::")
+			} else {
+				b.WriteString(":")
+			}
+			ignore0 = true
+			continue
+		}
+
+		b.WriteString(strconv.FormatUint(uint64(piece), 16))
+		if i != 7 {
+			b.WriteString(":")
+		}
+	}
+
+	return b.String()
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = BuildObjectStorageURLFunction{}
+
+type BuildObjectStorageURLFunction struct{}
+
+// objectStorageURLParts are the attributes of the parse_object_storage_url
+// result.
+var objectStorageURLParts = []string{
+	"provider",
+	"style",
+	"bucket",
+	"key",
+	"region",
+	"account",
+	"endpoint",
+}
+
+func NewBuildObjectStorageURLFunction() function.Function {
+	return BuildObjectStorageURLFunction{}
+}
+
+func (f BuildObjectStorageURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_object_storage_url"
+}
+
+func (f BuildObjectStorageURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             buildObjectStorageURLMarkdownDescription,
+		MarkdownDescription: buildObjectStorageURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "parts",
+				MarkdownDescription: objectStoragePartsAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f BuildObjectStorageURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		parts types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	objectStorageURLModel, err := toObjectStorageURLModel(ctx, parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, err.Error()),
+		)
+		return
+	}
+
+	url, err := netparse.BuildObjectStorageURL(objectStorageURLModel)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, url))
+}
+
+// toObjectStorageURLModel reads the parts of an object storage URL from an
+// object shaped like the parse_object_storage_url result.
+func toObjectStorageURLModel(ctx context.Context, value types.Dynamic) (*netparse.ObjectStorageURLModel, error) {
+	parts, err := newObjectArgument(ctx, value, objectStorageURLParts...)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &netparse.ObjectStorageURLModel{}
+	fields := map[string]*string{
+		"provider": &o.Provider,
+		"style":    &o.Style,
+		"bucket":   &o.Bucket,
+		"key":      &o.Key,
+		"region":   &o.Region,
+		"account":  &o.Account,
+		"endpoint": &o.Endpoint,
+	}
+	for name, field := range fields {
+		*field, err = parts.String(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
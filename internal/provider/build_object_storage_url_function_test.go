@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestBuildObjectStorageURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url(merge(
+						provider::netparse::parse_object_storage_url("https://my-bucket.s3.eu-west-1.amazonaws.com/path/to/object.csv"),
+						{ style = "uri" },
+					))
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("s3://my-bucket/path/to/object.csv"),
+					),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url({
+						provider = "s3"
+						style    = "path"
+						bucket   = "my-bucket"
+						key      = "reports/2024 Q1.csv"
+						region   = "us-west-2"
+					})
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://s3.us-west-2.amazonaws.com/my-bucket/reports/2024%20Q1.csv"),
+					),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url({
+						provider = "azure"
+						bucket   = "mycontainer"
+						key      = "blob.txt"
+						account  = "myaccount"
+					})
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://myaccount.blob.core.windows.net/mycontainer/blob.txt"),
+					),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url({ provider = "azure", style = "uri", bucket = "c", account = "a" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unsupported style "uri" for provider "azure"`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url({ provider = "s3", container = "c" })
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unsupported attribute "container"`),
+			},
+		},
+	})
+}
+
+func TestBuildObjectStorageURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::build_object_storage_url(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
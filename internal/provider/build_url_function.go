@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = BuildURLFunction{}
+
+type BuildURLFunction struct{}
+
+// buildURLComponents are the attributes of the parse_url result used to build
+// the URL. The other attributes of the parse_url result are derived from them,
+// so they are accepted and ignored.
+var buildURLComponents = []string{
+	"scheme",
+	"username",
+	"password",
+	"host",
+	"port",
+	"path",
+	"query",
+	"fragment",
+	"opaque",
+	"raw_path",
+	"raw_fragment",
+	"force_query",
+	"omit_host",
+	"authority",
+	"credentials",
+	"protocol",
+	"search",
+	"hash",
+	"host_type",
+	"zone",
+	"domain",
+	"effective_port",
+	"is_default_port",
+	"origin",
+	"path_segments",
+}
+
+func NewBuildURLFunction() function.Function {
+	return BuildURLFunction{}
+}
+
+func (f BuildURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_url"
+}
+
+func (f BuildURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             buildURLMarkdownDescription,
+		MarkdownDescription: buildURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "components",
+				MarkdownDescription: componentsAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f BuildURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		components types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &components))
+	if resp.Error != nil {
+		return
+	}
+
+	urlModel, err := toURLModel(ctx, components)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, err.Error()),
+		)
+		return
+	}
+
+	url, err := netparse.BuildURL(urlModel)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, url))
+}
+
+// toURLModel reads the URL components from an object shaped like the parse_url
+// result. The query can be a string or a map of parameters.
+func toURLModel(ctx context.Context, value types.Dynamic) (*netparse.URLModel, error) {
+	components, err := newObjectArgument(ctx, value, buildURLComponents...)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &netparse.URLModel{}
+	fields := map[string]*string{
+		"scheme":       &u.Scheme,
+		"username":     &u.Username,
+		"password":     &u.Password,
+		"host":         &u.Host,
+		"port":         &u.Port,
+		"path":         &u.Path,
+		"fragment":     &u.Fragment,
+		"opaque":       &u.Opaque,
+		"raw_path":     &u.RawPath,
+		"raw_fragment": &u.RawFragment,
+	}
+	for name, field := range fields {
+		*field, err = components.String(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if u.ForceQuery, err = components.Bool("force_query"); err != nil {
+		return nil, err
+	}
+
+	if u.OmitHost, err = components.Bool("omit_host"); err != nil {
+		return nil, err
+	}
+
+	if _, ok := components["query"].(types.String); ok {
+		u.Query, err = components.String("query")
+	} else {
+		var params map[string][]string
+		params, err = components.StringListMap("query")
+		u.Query = netparse.EncodeQuery(params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
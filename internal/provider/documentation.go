@@ -17,21 +17,181 @@ const (
 var parseURLMarkdownDescription = describeFunction(urlMarkdownDescription, urlDataSourceTypeName)
 
 const (
-	urlMarkdownDescription                  = "Parses URL components from a URL string. It uses the [net/url](https://pkg.go.dev/net/url) go package to parse the URL. For more details on the URL components, see [What is a URL?](https://developer.mozilla.org/en-US/docs/Learn/Common_questions/What_is_a_URL) and [WHATWG URL Standard](https://url.spec.whatwg.org/#api)."
-	urlAttributeMarkdownDescription         = "The URL to parse."
-	authorityAttributeMarkdownDescription   = "The concatenation of the username, password, host, and port. It's separated from the scheme by `://`."
-	credentialsAttributeMarkdownDescription = "The concatenation of the username and password."
-	fragmentAttributeMarkdownDescription    = "The component after the search."
-	hashAttributeMarkdownDescription        = "The concatenation of a `#` with the fragment."
-	hostAttributeMarkdownDescription        = "The domain part of the authority."
-	passwordAttributeMarkdownDescription    = "The second component of the credentials."
-	protocolAttributeMarkdownDescription    = "The concatenation of the protocol scheme and `:`."
-	schemeAttributeMarkdownDescription      = "The protocol scheme used to access the domain."
-	usernameAttributeMarkdownDescription    = "The first component of the credentials."
-	portAttributeMarkdownDescription        = "The last component of the authority."
-	pathAttributeMarkdownDescription        = "The component after the authority."
-	queryAttributeMarkdownDescription       = "A substring of the search component, after the `?` and before the fragment."
-	searchAttributeMarkdownDescription      = "The component after the path."
+	urlMarkdownDescription                    = "Parses URL components from a URL string. It uses the [net/url](https://pkg.go.dev/net/url) go package to parse the URL, or a [WHATWG URL Standard](https://url.spec.whatwg.org/) parser when the `whatwg` mode is selected. For more details on the URL components, see [What is a URL?](https://developer.mozilla.org/en-US/docs/Learn/Common_questions/What_is_a_URL) and [WHATWG URL Standard](https://url.spec.whatwg.org/#api)."
+	urlDataSourceMarkdownDescription          = urlMarkdownDescription + " The `spans` of the components are only available in the `rfc3986` mode, because the `whatwg` mode normalizes the URL, so its components are not substrings of the input."
+	urlAttributeMarkdownDescription           = "The URL to parse."
+	modeAttributeMarkdownDescription          = "The parser to use. It can be one of: `rfc3986`, which uses the [net/url](https://pkg.go.dev/net/url) go package, or `whatwg`, which follows the [WHATWG URL Standard](https://url.spec.whatwg.org/) like browsers and the Node.js `URL` class do. Defaults to `rfc3986`."
+	parseURLOptionsMarkdownDescription        = "An optional object with the parsing options. The `mode` attribute selects the parser. It can be one of: `rfc3986`, which uses the [net/url](https://pkg.go.dev/net/url) go package, or `whatwg`, which follows the [WHATWG URL Standard](https://url.spec.whatwg.org/) like browsers and the Node.js `URL` class do. Defaults to `rfc3986`. The `infer_scheme` attribute parses the URLs without a scheme, like `db.internal:5432` or `example.com/path`, as network locations with the scheme in the `default_scheme` attribute, which defaults to `https`."
+	authorityAttributeMarkdownDescription     = "The concatenation of the username, password, host, and port. It's separated from the scheme by `://`."
+	credentialsAttributeMarkdownDescription   = "The concatenation of the username and password."
+	fragmentAttributeMarkdownDescription      = "The component after the search."
+	hashAttributeMarkdownDescription          = "The concatenation of a `#` with the fragment."
+	hostAttributeMarkdownDescription          = "The domain part of the authority."
+	passwordAttributeMarkdownDescription      = "The second component of the credentials."
+	protocolAttributeMarkdownDescription      = "The concatenation of the protocol scheme and `:`."
+	schemeAttributeMarkdownDescription        = "The protocol scheme used to access the domain."
+	usernameAttributeMarkdownDescription      = "The first component of the credentials."
+	redactedURLAttributeMarkdownDescription   = "The URL with the password replaced by `xxxxx`. In the `rfc3986` mode, the rest of the URL is kept byte for byte, with the inferred scheme when `infer_scheme` is `true`. In the `whatwg` mode, it's the serialization of the parsed URL. It's null when the URL can't be redacted."
+	hostTypeAttributeMarkdownDescription      = "The type of the host. It can be one of: `ipv4`, `ipv6`, `domain`, or `opaque` for hosts that are neither an IP address nor a domain name. It's empty when the URL has no host."
+	zoneAttributeMarkdownDescription          = "The zone of a scoped IPv6 address, like `eth0` in `[fe80::1%25eth0]`. It's empty for other hosts."
+	hostDomainAttributeMarkdownDescription    = "The breakdown of the host, like the result of the `parse_domain` function, when the host is a domain name. It's null for other hosts and for domain names without a registrable domain, like `localhost`."
+	portAttributeMarkdownDescription          = "The last component of the authority."
+	pathAttributeMarkdownDescription          = "The component after the authority."
+	effectivePortAttributeMarkdownDescription = "The port that the URL connects to. It's the `port` when set, or the default port of the scheme, like `443` for `https` or `5432` for `postgres`. It's null when the URL has no port and the scheme has no known default port."
+	isDefaultPortAttributeMarkdownDescription = "Whether the effective port is the default port of the scheme."
+	queryAttributeMarkdownDescription         = "A substring of the search component, after the `?` and before the fragment."
+	searchAttributeMarkdownDescription        = "The component after the path."
+	opaqueAttributeMarkdownDescription        = "The part after the scheme of a URL whose path doesn't start with `/`, like `isbn:0451450523` in `urn:isbn:0451450523` or `blank` in `about:blank`. The `path` is empty for these URLs in `rfc3986` mode. It's empty for other URLs."
+	rawPathAttributeMarkdownDescription       = "The path as it's encoded in the URL, like `/a%2Fb` when the `path` is `/a/b`."
+	pathSegmentsAttributeMarkdownDescription  = "The percent-decoded segments of the path, split on each `/` that is not encoded. The leading `/` is removed, so the path `/a%2Fb/c/` has the segments `a/b`, `c` and an empty segment, and an empty path has no segments."
+	forceQueryAttributeMarkdownDescription    = "Whether the URL has a `?` with an empty query."
+	omitHostAttributeMarkdownDescription      = "Whether the URL has a scheme and a path starting with `/`, but no authority, like `file:/etc/hosts`."
+	rawFragmentAttributeMarkdownDescription   = "The fragment as it's encoded in the URL."
+	inferSchemeAttributeMarkdownDescription   = "Whether to parse the URLs without a scheme as network locations with the `default_scheme`. It detects an authority, like `db.internal:5432` or `[::1]:6379`, a host with a path, like `example.com/path`, an authority with userinfo, like `user:pass@db.internal:5432`, and a reference starting with `//`, instead of parsing the host as the scheme or the path. Paths starting with `/`, `.`, `?` or `#` and URLs with a scheme are parsed as is, and so are the inputs with a registered scheme, like `mailto:ops@example.com`, `tel:5551234` or `redis:6379`. Defaults to `false`."
+	defaultSchemeAttributeMarkdownDescription = "The scheme of the URLs without one when `infer_scheme` is `true`. Defaults to `https`."
+	includeSpansAttributeMarkdownDescription  = "Whether to return the `spans` of the URL components. Only supported in the `rfc3986` mode. Defaults to `false`."
+	spansAttributeMarkdownDescription         = "The position of each component in the `url`, by component name, when `include_spans` is `true`. The names are `scheme`, `opaque`, `username`, `password`, `host`, `port`, `path`, `query` and `fragment`, and the components that are not in the URL or are empty, like the host of `file:///etc/hosts` or the query of `https://example.com/?`, have no span. The spans cover the components as they are written, so the `host` span includes the brackets of an IPv6 address, and the `query` span doesn't include the `?`. It's null when `include_spans` is not `true`."
+	spanStartAttributeMarkdownDescription     = "The byte offset of the start of the component."
+	spanEndAttributeMarkdownDescription       = "The byte offset of the end of the component, which is exclusive, so the component is `substr(url, start, end - start)` for ASCII URLs."
+	originAttributeMarkdownDescription        = "The [origin](https://url.spec.whatwg.org/#origin) of the URL, serialized as the scheme, host and port, like `https://example.com:8443`. The scheme and host are lowercased, the host is converted to ASCII, like `xn--bcher-kva.example` for `bücher.example`, and the default port is omitted, as in `same_origin`. Only `ftp`, `http`, `https`, `ws` and `wss` URLs have an origin, and `blob:` URLs have the origin of the URL they contain. It's null for other URLs, whose origin is opaque."
+)
+
+const (
+	buildURLMarkdownDescription       = "Builds a URL string from its components. It's the inverse of the `parse_url` function: each component is escaped, IPv6 hosts are enclosed in brackets, and parsing the result returns the same components."
+	componentsAttrMarkdownDescription = "An object with the `scheme`, `username`, `password`, `host`, `port`, `path`, `query` and `fragment` of the URL, like the result of the `parse_url` function. All attributes are optional. The `query` can be a string or a map from each parameter name to a value or a list of values. The `opaque`, `raw_path`, `raw_fragment`, `force_query` and `omit_host` attributes are also used, so URLs like `urn:isbn:0451450523` or paths with `%2F` are built as they were parsed. The `raw_path` and `raw_fragment` are ignored when they are not an encoding of the `path` and `fragment`. The derived `authority`, `credentials`, `protocol`, `search`, `hash`, `host_type`, `zone`, `domain`, `effective_port`, `is_default_port`, `origin` and `path_segments` attributes are ignored."
+)
+
+const (
+	sameOriginMarkdownDescription      = "Checks if two URLs have the same [origin](https://html.spec.whatwg.org/multipage/browsers.html#same-origin), that is, the same scheme, host and port, as browsers do for CORS and the same-origin policy. The URLs are parsed following the [WHATWG URL Standard](https://url.spec.whatwg.org/), so `https://EXAMPLE.com:443` and `https://example.com` have the same origin. URLs with an opaque origin, like `file:` or `data:` URLs, are never same origin."
+	sameSiteMarkdownDescription        = "Checks if two URLs are [same site](https://html.spec.whatwg.org/multipage/browsers.html#same-site), as browsers do for `SameSite` cookies. The site of a URL is its scheme and registrable domain, like `https://example.co.uk` for `https://app.example.co.uk`, which is found with the `parse_domain` function. Hosts without a registrable domain, like IP addresses and `localhost`, must be equal. The URLs are parsed following the [WHATWG URL Standard](https://url.spec.whatwg.org/), and URLs with an opaque origin are never same site."
+	sameSiteOptionsMarkdownDescription = "An optional object with the comparison options. When `schemeful` is `false`, the schemes are not compared, like the [schemelessly same site](https://html.spec.whatwg.org/multipage/browsers.html#schemelessly-same-site) check. Defaults to `true`."
+	firstURLAttrMarkdownDescription    = "The first URL to compare."
+	secondURLAttrMarkdownDescription   = "The second URL to compare."
+)
+
+const (
+	normalizeURLMarkdownDescription        = "Normalizes a URL so that equivalent URLs compare equal as strings. It applies the [RFC 3986](https://www.rfc-editor.org/rfc/rfc3986#section-6) normalizations: it lowercases the scheme and host, except the zone of an IPv6 address, removes the default port and the dot-segments of the path, uppercases the hexadecimal digits of percent-encodings and decodes percent-encoded unreserved characters."
+	normalizeURLOptionsMarkdownDescription = "An optional object with the normalization options. When `sort_query` is `true`, the query parameters are sorted by name, and empty parameters, like the one after the trailing `&` of `?a=1&`, are removed. When `remove_empty_fragment` is `true`, a `#` without a fragment is removed. Both default to `false`."
+)
+
+const (
+	resolveURLMarkdownDescription       = "Resolves a URL reference against a base URL, following the reference resolution of [RFC 3986](https://www.rfc-editor.org/rfc/rfc3986#section-5). It supports relative paths with `./` and `../` segments, absolute paths, protocol-relative `//host` references, and query-only or fragment-only references. The base URL must be absolute and hierarchical, so URLs like `mailto:user@example.com` or `urn:isbn:0451450523` can't be a base."
+	baseURLAttrMarkdownDescription      = "The absolute URL that the reference is resolved against."
+	referenceURLAttrMarkdownDescription = "The URL reference to resolve. If it's an absolute URL, it's returned as is."
+)
+
+const (
+	fileURLToPathMarkdownDescription  = "Converts a `file:` URL to a filesystem path, as defined by [RFC 8089](https://www.rfc-editor.org/rfc/rfc8089). The path is percent-decoded, and the query and fragment are ignored. POSIX paths require an empty or `localhost` host. Windows paths are either a drive letter path, like `C:\\Users` from `file:///C:/Users`, or a UNC path, like `\\\\server\\share` from `file://server/share`. The conversion doesn't depend on the operating system that runs Terraform."
+	pathToFileURLMarkdownDescription  = "Converts an absolute filesystem path to a `file:` URL, as defined by [RFC 8089](https://www.rfc-editor.org/rfc/rfc8089). The characters that are not allowed in a URL path, and `%`, are percent-encoded, and `.` and `..` segments are resolved. Windows paths use `\\` or `/` as separators and start with a drive letter, like `C:\\Users`, or a UNC share, like `\\\\server\\share`, whose server becomes the host of the URL. The conversion doesn't depend on the operating system that runs Terraform."
+	fileURLAttrMarkdownDescription    = "The `file:` URL to convert."
+	filePathAttrMarkdownDescription   = "The absolute path to convert."
+	fileURLOptionsMarkdownDescription = "An optional object with the conversion options. Set the `windows` attribute to `true` to use Windows paths instead of POSIX paths. Defaults to `false`."
+)
+
+const (
+	urlEscapeMarkdownDescription         = "Percent-encodes a value to use it as a component of a URL. Unlike the `urlencode` function, which uses the query encoding for everything, each component has its own set of characters to encode, based on the percent-encode sets of the [WHATWG URL Standard](https://url.spec.whatwg.org/#percent-encoded-bytes) and the delimiters of [RFC 3986](https://www.rfc-editor.org/rfc/rfc3986#section-2.2). A `path-segment` encodes `/`, while a `path` keeps it. A `query-key` encodes `&`, `+` and `=`, while a `query-value` keeps `=`. The `userinfo` encodes `:` and `@`. A `host` keeps only the unreserved characters and the sub-delimiters of a registered name. A space is always encoded as `%20`, and `%` is always encoded."
+	urlUnescapeMarkdownDescription       = "Decodes the percent-encoded bytes of a URL component. In a `query-key` or `query-value`, a `+` is also decoded as a space, following the [application/x-www-form-urlencoded](https://url.spec.whatwg.org/#application/x-www-form-urlencoded) format. It fails when a `%` is not followed by two hex digits or when the decoded value is not valid UTF-8."
+	escapeValueAttrMarkdownDescription   = "The value to encode."
+	unescapeValueAttrMarkdownDescription = "The percent-encoded value to decode."
+	urlComponentAttrMarkdownDescription  = "The URL component of the value. It can be one of: `path-segment`, `path`, `query-key`, `query-value`, `userinfo`, `fragment` or `host`."
+)
+
+const (
+	iriToURIMarkdownDescription = "Converts an internationalized resource identifier (IRI) to a URI, as defined by [RFC 3987](https://www.rfc-editor.org/rfc/rfc3987#section-3.1). A host with non-ASCII characters is converted to ASCII with IDNA, like `xn--bcher-kva.example` for `bücher.example`, and the other non-ASCII characters are percent-encoded as UTF-8. The ASCII characters, including existing percent-encodings, are kept as is."
+	uriToIRIMarkdownDescription = "Converts a URI to an internationalized resource identifier (IRI) for display, as defined by [RFC 3987](https://www.rfc-editor.org/rfc/rfc3987#section-3.2). The percent-encoded UTF-8 sequences are decoded only when they encode characters allowed in an IRI, so the encodings of ASCII characters, invalid UTF-8, bidirectional formatting characters and, outside the query, private use characters are kept. The punycode labels of the host are converted to Unicode."
+	iriAttrMarkdownDescription  = "The IRI to convert, like `https://bücher.example/straße`."
+	uriAttrMarkdownDescription  = "The URI to convert, like `https://xn--bcher-kva.example/stra%C3%9Fe`."
+)
+
+const (
+	redactURLMarkdownDescription        = "Replaces the password of a URL, and optionally the username and the values of chosen query parameters, with a mask. The rest of the URL is kept byte for byte, so the result can be shown in outputs and logs."
+	redactURLOptionsMarkdownDescription = "An optional object with the redaction options. The `mask` attribute replaces each redacted component and defaults to `xxxxx`. When `redact_username` is `true`, the username is redacted too. The `query_params` attribute is a list of query parameter names, matched case-insensitively, whose values are redacted."
+)
+
+var parseDSNMarkdownDescription = describeFunction(dsnMarkdownDescription, dsnDataSourceTypeName)
+
+const (
+	dsnMarkdownDescription             = "Parses a database connection string into its driver, hosts, ports, database, user, password and parameters. It accepts URLs like `postgres://user@host:5432/db`, [JDBC](https://learn.microsoft.com/en-us/sql/connect/jdbc/building-the-connection-url) URLs, [go-sql-driver/mysql](https://github.com/go-sql-driver/mysql#dsn-data-source-name) DSNs like `user:pass@tcp(host:3306)/db`, [PostgreSQL](https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING) `key=value` connection strings and [SQL Server](https://learn.microsoft.com/en-us/dotnet/api/system.data.sqlclient.sqlconnection.connectionstring) connection strings like `Server=host;Database=db`."
+	dsnAttrMarkdownDescription         = "The database connection string to parse."
+	driverAttrMarkdownDescription      = "The database driver, like `postgres`, `mysql` or `sqlserver`. It's taken from the URL scheme, with `postgresql` normalized to `postgres`, `mssql` to `sqlserver` and `mariadb` to `mysql`."
+	hostsAttrMarkdownDescription       = "The hosts of the connection string, in order. IPv6 addresses are not enclosed in brackets. A MySQL Unix socket is listed by its path."
+	portsAttrMarkdownDescription       = "The port of each host. When a host has no port, it's the default port of the driver, or `0` when the driver has none."
+	databaseAttrMarkdownDescription    = "The database name."
+	userAttrMarkdownDescription        = "The user name."
+	dsnPasswordAttrMarkdownDescription = "The password."
+	dsnParamsAttrMarkdownDescription   = "The other parameters of the connection string. The user, password and database set as parameters, like in JDBC URLs, are moved to their own attributes."
+)
+
+const (
+	parseDataURIMarkdownDescription = "Parses a data URI, as defined by [RFC 2397](https://www.rfc-editor.org/rfc/rfc2397), and decodes its payload. It follows the [data: URL processor](https://fetch.spec.whatwg.org/#data-url-processor) of the Fetch Standard, so a missing or invalid media type defaults to `text/plain;charset=US-ASCII`. The `data` attribute is the decoded payload, or null when it's not valid UTF-8, like for images, and `data_base64` is the decoded payload encoded in base64."
+	dataURIAttrMarkdownDescription  = "The data URI to parse, like `data:text/plain;base64,SGVsbG8=`."
+)
+
+const (
+	parseMailtoMarkdownDescription = "Parses a mailto URI, as defined by [RFC 6068](https://www.rfc-editor.org/rfc/rfc6068), into its recipients and header fields. All values are percent-decoded, and a `+` is kept as is. The addresses of the `to` header fields are added to the `to` attribute, and the other header fields, except `cc`, `bcc`, `subject` and `body`, are returned in `headers` with lowercase names."
+	mailtoAttrMarkdownDescription  = "The mailto URI to parse, like `mailto:ops@example.com?subject=Alert`."
+)
+
+const (
+	parseURNMarkdownDescription = "Parses a URN, as defined by [RFC 8141](https://www.rfc-editor.org/rfc/rfc8141), into its namespace identifier `nid`, which is lowercased, its namespace-specific string `nss`, and its `r_component` after `?+`, `q_component` after `?=` and `f_component` after `#`, which are empty when not set. The components are validated but not percent-decoded. Two URNs are equivalent when their `normalized` attributes are equal. It's the `urn:<nid>:<nss>` assigned name with the `urn` prefix and the namespace identifier lowercased and the hex digits of percent-encodings uppercased, so the r-, q- and f-components are ignored. The equivalence rules of each namespace, like case-insensitive UUIDs, are not applied."
+	urnAttrMarkdownDescription  = "The URN to parse, like `urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66`."
+)
+
+const (
+	parseGitURLMarkdownDescription = "Parses a git remote URL. It accepts the `ssh`, `https`, `http`, `git` and `file` URLs described in [git clone](https://git-scm.com/docs/git-clone#_git_urls), and the scp-like syntax `[user@]host:path`, like `git@github.com:org/repo.git`. It also returns the equivalent `https_url` and `ssh_url` forms of the remote, which are null for the `file` transport. The `ssh_url` is an `ssh://` URL when the port is not 22 or the path is absolute, like `ssh://git@host/srv/git/repo.git` for `git@host:/srv/git/repo.git`, because the path of the scp-like syntax is relative to the home directory."
+	gitURLAttrMarkdownDescription  = "The git remote URL to parse."
+)
+
+const (
+	parseObjectStorageURLMarkdownDescription  = "Parses the URL of an object in Amazon S3, Google Cloud Storage or Azure Blob Storage, and detects its provider and style. It accepts `s3://` and `gs://` URIs, virtual-hosted URLs like `https://bucket.s3.eu-west-1.amazonaws.com/key`, path-style URLs like `https://storage.googleapis.com/bucket/key`, and Azure URLs like `https://account.blob.core.windows.net/container/blob`. The `bucket` is the container for Azure, and the `key` is percent-decoded, except in `s3://` and `gs://` URIs, where it's everything after the bucket as written, like `reports/a?b#c.csv` in `s3://bucket/reports/a?b#c.csv`, as the AWS and Google Cloud CLIs read it. The `region` is only set for S3 hosts that include it, the `account` is only set for Azure, and the `endpoint` is the host without the bucket or the account, like `s3.eu-west-1.amazonaws.com`. The query and fragment of HTTPS URLs are ignored."
+	buildObjectStorageURLMarkdownDescription  = "Builds the URL of an object in Amazon S3, Google Cloud Storage or Azure Blob Storage from its parts. It's the reverse of the `parse_object_storage_url` function, so changing the `style` of its result converts the URL to another style."
+	objectStorageURLAttrMarkdownDescription   = "The object storage URL to parse."
+	objectStoragePartsAttrMarkdownDescription = "An object with the parts of the URL, shaped like the result of the `parse_object_storage_url` function. The `provider` and `bucket` attributes are required, and the others are optional. The `style` defaults to `uri`, or to `path` for `azure`, which only supports the `path` style and requires the `account`. When the `endpoint` is not set, it's derived from the `region` for `s3`, and is the public endpoint of the service otherwise."
+)
+
+const (
+	parseImageReferenceMarkdownDescription = "Parses a container image reference, like `registry.example.com:5000/team/app:1.2@sha256:...`, following the [distribution reference](https://github.com/distribution/reference) grammar used by Docker and OCI registries. The first path component is the registry when it's `localhost` or has a dot, a port or an uppercase letter, like IP addresses and domain names do. Otherwise, the registry is `docker.io`, and official images like `nginx` get the `library/` namespace, so the normalized `name` is `docker.io/library/nginx`. The `tag`, `digest_algorithm` and `digest_hex` are empty when not set, and the `port` is null."
+	imageReferenceAttrMarkdownDescription  = "The image reference to parse."
+)
+
+const (
+	expandURITemplateMarkdownDescription   = "Expands a URI template, following all the levels of [RFC 6570](https://www.rfc-editor.org/rfc/rfc6570). It supports the simple `{var}`, reserved `{+var}`, fragment `{#var}`, label `{.var}`, path segment `{/var}`, path-style parameter `{;var}`, form-style query `{?var}` and query continuation `{&var}` expressions, with the explode `{var*}` and prefix `{var:3}` modifiers. Each value is percent-encoded as the expression requires."
+	uriTemplateAttrMarkdownDescription     = "The URI template to expand, like `https://api.example.com/users{/id}{?fields*}`."
+	uriTemplateVarsAttrMarkdownDescription = "An object with the value of each variable. A value can be a string, a number, a bool, a list of strings, or an object or map of strings, which is expanded as an associative array in the order of its keys. Variables that are missing or null, and empty lists, objects or maps, are undefined and expand to nothing."
+)
+
+const (
+	urlPatternMatchMarkdownDescription   = "Matches a URL against a pattern of the [URLPattern Standard](https://urlpattern.spec.whatwg.org/), and returns the groups captured in each URL component. A pattern like `https://*.example.com/api/:version/*` uses the `*` wildcard, named groups like `:version` that match up to the next `/` in the pathname or `.` in the hostname, regexp groups like `:id(\\d+)`, optional `{...}?` groups and the `?`, `*` and `+` modifiers. Regexp groups use the [Go RE2 syntax](https://github.com/google/re2/wiki/Syntax), so lookarounds like `(?!...)` and backreferences are not supported. The hostname of a pattern with a special scheme, like `https`, is converted to ASCII and lowercased, like the host of a URL, so `https://Bücher.example/*` matches `https://xn--bcher-kva.example/`. Unnamed groups are numbered from `0` in each component. The URL matches when every component does; a URL that can't be parsed doesn't match. The `groups` are null when the URL doesn't match, and an optional group that didn't match is null."
+	urlPatternAttrMarkdownDescription    = "The pattern to match, either an absolute pattern string like `https://example.com/books/:id` or an object with the pattern of some of the `protocol`, `username`, `password`, `hostname`, `port`, `pathname`, `search` and `hash` components. In a pattern string, the username, password, search and hash match anything when not set, and the port matches no port when the hostname is set. In an object, the components that are not set match anything. Characters with a special meaning in patterns, like `:` in an IPv6 hostname, are escaped with `\\`."
+	urlPatternOptionsMarkdownDescription = "An optional object with the matching options. Set `ignore_case` to `true` to match the pathname, search and hash case-insensitively."
+)
+
+var validateURLMarkdownDescription = describeFunction(urlValidationMarkdownDescription, urlValidationDataSourceTypeName)
+
+const (
+	urlValidationMarkdownDescription           = "Validates a URL against a policy and returns the violations of its rules. Each violation has the `rule` that the URL doesn't follow, like `require_tls`, and a `message`. A URL that can't be parsed violates the `syntax` rule, and a URL longer than `max_length` violates the `max_length` rule."
+	urlValidationPolicyMarkdownDescription     = "An object with the rules of the policy. All attributes are optional: `allowed_schemes` and `allowed_host_suffixes` are lists of strings, `require_tls`, `forbid_credentials` and `forbid_ip_hosts` are bools, and `min_port`, `max_port` and `max_length` are numbers. Each attribute has the same meaning as the argument of the same name of the `netparse_url_validation` data source."
+	allowedSchemesAttrMarkdownDescription      = "The allowed schemes, compared case-insensitively. Any scheme is allowed when not set."
+	requireTLSAttrMarkdownDescription          = "Whether the scheme must be secured with TLS, like `https`, `wss` or `ldaps`."
+	forbidCredentialsAttrMarkdownDescription   = "Whether a username or password is forbidden."
+	forbidIPHostsAttrMarkdownDescription       = "Whether hosts that are IPv4 or IPv6 addresses are forbidden."
+	allowedHostSuffixesAttrMarkdownDescription = "The domains that the host must be equal to or a subdomain of, like `example.com`. Any host is allowed when not set."
+	minPortAttrMarkdownDescription             = "The minimum effective port. The effective port is the port of the URL, or the default port of the scheme. A URL without an effective port violates the `port_range` rule when `min_port` or `max_port` is set."
+	maxPortAttrMarkdownDescription             = "The maximum effective port."
+	maxLengthAttrMarkdownDescription           = "The maximum length of the URL in bytes."
+	failOnViolationAttrMarkdownDescription     = "Whether reading the data source fails when the URL violates the policy, which stops the plan. Defaults to `true`."
+	validAttrMarkdownDescription               = "Whether the URL follows all the rules of the policy."
+	violationsAttrMarkdownDescription          = "The violations of the rules of the policy."
+	violationRuleAttrMarkdownDescription       = "The name of the violated rule. It's the name of the policy attribute, `port_range` for `min_port` and `max_port`, or `syntax`."
+	violationMessageAttrMarkdownDescription    = "The description of the violation."
+)
+
+var parseQueryMarkdownDescription = describeFunction(queryMarkdownDescription, queryDataSourceTypeName)
+
+const (
+	queryMarkdownDescription           = "Parses the parameters of a URL query string. It decodes the query with the [application/x-www-form-urlencoded parser](https://url.spec.whatwg.org/#concept-urlencoded-parser) of the WHATWG URL Standard, so `+` is decoded as a space and percent-encoded bytes are decoded as UTF-8."
+	queryStringAttrMarkdownDescription = "The query string to parse, like the `query` or `search` attribute of a parsed URL. A leading `?` is ignored."
+	paramsAttrMarkdownDescription      = "The parameters of the query. Each name maps to the list of its values, in the order they appear in the query."
 )
 
 var parseCIDRMarkdownDescription = describeFunction(cidrMarkdownDescription, cidrDataSourceTypeName)
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var dsnDataSourceTypeName = fmt.Sprintf("%s_dsn", providerTypeName)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &dsnDataSource{}
+
+// dsnDataSource defines the data source implementation.
+type dsnDataSource struct{}
+
+// dsnDataSourceModel describes the data source model.
+type dsnDataSourceModel struct {
+	DSN      types.String `tfsdk:"dsn"`
+	Driver   types.String `tfsdk:"driver"`
+	Hosts    types.List   `tfsdk:"hosts"`
+	Ports    types.List   `tfsdk:"ports"`
+	Database types.String `tfsdk:"database"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	Params   types.Map    `tfsdk:"params"`
+}
+
+func NewDSNDataSource() datasource.DataSource {
+	return &dsnDataSource{}
+}
+
+func (d *dsnDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dsn"
+}
+
+func (d *dsnDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: dsnMarkdownDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"dsn": schema.StringAttribute{
+				MarkdownDescription: dsnAttrMarkdownDescription,
+				Required:            true,
+				Sensitive:           true,
+			},
+			"driver": schema.StringAttribute{
+				MarkdownDescription: driverAttrMarkdownDescription,
+				Computed:            true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: hostsAttrMarkdownDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"ports": schema.ListAttribute{
+				MarkdownDescription: portsAttrMarkdownDescription,
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"database": schema.StringAttribute{
+				MarkdownDescription: databaseAttrMarkdownDescription,
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: userAttrMarkdownDescription,
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: dsnPasswordAttrMarkdownDescription,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"params": schema.MapAttribute{
+				MarkdownDescription: dsnParamsAttrMarkdownDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *dsnDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dsnDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := data.update(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update data", err.Error())
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *dsnDataSourceModel) update(ctx context.Context) error {
+	dsn, err := netparse.ParseDSN(d.DSN.ValueString())
+	if err != nil {
+		return fmt.Errorf("failed to parse DSN: %w", err)
+	}
+
+	result := FromDSNModel(dsn)
+
+	hosts, diags := types.ListValueFrom(ctx, types.StringType, result.Hosts)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert hosts: %v", diags)
+	}
+
+	ports, diags := types.ListValueFrom(ctx, types.Int64Type, result.Ports)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert ports: %v", diags)
+	}
+
+	params, diags := types.MapValueFrom(ctx, types.StringType, result.Params)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert params: %v", diags)
+	}
+
+	d.Driver = types.StringValue(result.Driver)
+	d.Hosts = hosts
+	d.Ports = ports
+	d.Database = types.StringValue(result.Database)
+	d.User = types.StringValue(result.User)
+	d.Password = types.StringValue(result.Password)
+	d.Params = params
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDSNDataSource(t *testing.T) {
+	resourceFqn := "data.netparse_dsn.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceFqn,
+				Config:       testAccDSNDataSource("host=db1.example.com,db2.example.com port=5432,5433 dbname=orders user=app password='s3cr3t' sslmode=require"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFqn, "driver", "postgres"),
+					resource.TestCheckResourceAttr(resourceFqn, "hosts.#", "2"),
+					resource.TestCheckResourceAttr(resourceFqn, "hosts.0", "db1.example.com"),
+					resource.TestCheckResourceAttr(resourceFqn, "hosts.1", "db2.example.com"),
+					resource.TestCheckResourceAttr(resourceFqn, "ports.0", "5432"),
+					resource.TestCheckResourceAttr(resourceFqn, "ports.1", "5433"),
+					resource.TestCheckResourceAttr(resourceFqn, "database", "orders"),
+					resource.TestCheckResourceAttr(resourceFqn, "user", "app"),
+					resource.TestCheckResourceAttr(resourceFqn, "password", "s3cr3t"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.%", "1"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.sslmode", "require"),
+				),
+			},
+			{
+				ResourceName: resourceFqn,
+				Config:       testAccDSNDataSource("Server=tcp:sql.example.com,1433;Initial Catalog=orders;User ID=sa;Password=secret;Encrypt=True"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFqn, "driver", "sqlserver"),
+					resource.TestCheckResourceAttr(resourceFqn, "hosts.0", "sql.example.com"),
+					resource.TestCheckResourceAttr(resourceFqn, "ports.0", "1433"),
+					resource.TestCheckResourceAttr(resourceFqn, "database", "orders"),
+					resource.TestCheckResourceAttr(resourceFqn, "user", "sa"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.Encrypt", "True"),
+				),
+			},
+			{
+				ResourceName: resourceFqn,
+				Config:       testAccDSNDataSource("not a dsn"),
+				ExpectError:  regexp.MustCompile("unsupported DSN format"),
+			},
+		},
+	})
+}
+
+func testAccDSNDataSource(dsn string) string {
+	return fmt.Sprintf(`
+data "netparse_dsn" "test" {
+  dsn = %[1]q
+}
+`, dsn)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ExpandURITemplateFunction{}
+
+type ExpandURITemplateFunction struct{}
+
+func NewExpandURITemplateFunction() function.Function {
+	return ExpandURITemplateFunction{}
+}
+
+func (f ExpandURITemplateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_uri_template"
+}
+
+func (f ExpandURITemplateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             expandURITemplateMarkdownDescription,
+		MarkdownDescription: expandURITemplateMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "template",
+				MarkdownDescription: uriTemplateAttrMarkdownDescription,
+			},
+			function.DynamicParameter{
+				Name:                "vars",
+				MarkdownDescription: uriTemplateVarsAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ExpandURITemplateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		template string
+		vars     types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &vars))
+	if resp.Error != nil {
+		return
+	}
+
+	values, err := toURITemplateVars(ctx, vars)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	expanded, err := netparse.ExpandURITemplate(template, values)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, expanded))
+}
+
+// toURITemplateVars reads the variables of a URI template from an object or a
+// map. Lists become lists of strings and nested objects or maps become
+// associative arrays.
+func toURITemplateVars(ctx context.Context, value types.Dynamic) (map[string]any, error) {
+	attributes, err := dynamicAttributes(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]any, len(attributes))
+	for name, attribute := range attributes {
+		if attribute.IsNull() {
+			continue
+		}
+
+		variable := objectArgument{name: attribute}
+
+		switch attribute.(type) {
+		case types.List, types.Tuple, types.Set:
+			vars[name], err = variable.StringList(name)
+		case types.Object, types.Map:
+			vars[name], err = variable.StringMap(name)
+		default:
+			vars[name], err = variable.String(name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestExpandURITemplateFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExpandURITemplateFunctionConfig_basic(
+					"https://api.example.com/users{/id}{?fields*,q}",
+					`{
+						id     = 42
+						fields = ["name", "email"]
+						q      = "a b/c"
+					}`,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://api.example.com/users/42?fields=name&fields=email&q=a%20b%2Fc"),
+					),
+				},
+			},
+			{
+				Config: testAccExpandURITemplateFunctionConfig_basic(
+					"{+base}{/path*}{;params*}{#section}",
+					`{
+						base    = "https://example.com/docs"
+						path    = ["v1", "a b"]
+						params  = { lang = "en", dark = "" }
+						section = "intro/part 1"
+						unused  = null
+					}`,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://example.com/docs/v1/a%20b;dark;lang=en#intro/part%201"),
+					),
+				},
+			},
+			{
+				Config:      testAccExpandURITemplateFunctionConfig_basic("{unclosed", "{}"),
+				ExpectError: regexp.MustCompile(`unclosed expression`),
+			},
+			{
+				Config:      testAccExpandURITemplateFunctionConfig_basic("{list:3}", `{ list = ["a"] }`),
+				ExpectError: regexp.MustCompile(`prefix modifier in "list:3" applied to a list`),
+			},
+		},
+	})
+}
+
+func TestExpandURITemplateFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::expand_uri_template(null, {})
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccExpandURITemplateFunctionConfig_basic(template string, vars string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::expand_uri_template(%[1]q, %[2]s)
+}
+`, template, vars)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = FileURLToPathFunction{}
+
+type FileURLToPathFunction struct{}
+
+func NewFileURLToPathFunction() function.Function {
+	return FileURLToPathFunction{}
+}
+
+func (f FileURLToPathFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "file_url_to_path"
+}
+
+func (f FileURLToPathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             fileURLToPathMarkdownDescription,
+		MarkdownDescription: fileURLToPathMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: fileURLAttrMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: fileURLOptionsMarkdownDescription,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f FileURLToPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url     string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := toFileURLOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	path, err := netparse.FileURLToPath(url, opts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, path))
+}
+
+func toFileURLOptions(ctx context.Context, options []types.Dynamic) (netparse.FileURLOptions, error) {
+	var opts netparse.FileURLOptions
+
+	object, err := newFunctionOptions(ctx, options, "windows")
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.Windows, err = object.Bool("windows"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
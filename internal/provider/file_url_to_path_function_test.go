@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestFileURLToPathFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileURLToPathFunctionConfig_basic("file:///var/lib/app%20data/state.json", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("/var/lib/app data/state.json"),
+					),
+				},
+			},
+			{
+				Config: testAccFileURLToPathFunctionConfig_basic("file:///C:/Users/me/My%20Documents", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact(`C:\Users\me\My Documents`),
+					),
+				},
+			},
+			{
+				Config: testAccFileURLToPathFunctionConfig_basic("file://server/share/artifacts/app.zip", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact(`\\server\share\artifacts\app.zip`),
+					),
+				},
+			},
+			{
+				Config:      testAccFileURLToPathFunctionConfig_basic("file://server/share/app.zip", false),
+				ExpectError: regexp.MustCompile(`file URL host must be empty or localhost`),
+			},
+		},
+	})
+}
+
+func TestFileURLToPathFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::file_url_to_path(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccFileURLToPathFunctionConfig_basic(url string, windows bool) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::file_url_to_path(%[1]q, { windows = %[2]t })
+}
+`, url, windows)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// objectArgument holds the attributes of a dynamic function argument that
+// must be an object or a map. Using a dynamic argument lets callers set only
+// the attributes they need.
+type objectArgument map[string]attr.Value
+
+// newObjectArgument reads the attributes of value. It fails if value is not an
+// object or a map, or if it contains an attribute that is not in allowed. Null
+// attributes are treated as not set.
+func newObjectArgument(ctx context.Context, value types.Dynamic, allowed ...string) (objectArgument, error) {
+	object := objectArgument{}
+
+	attributes, err := dynamicAttributes(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, attribute := range attributes {
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("unsupported attribute %q, expected one of: %s", name, strings.Join(slices.Sorted(slices.Values(allowed)), ", "))
+		}
+
+		if !attribute.IsNull() {
+			object[name] = attribute
+		}
+	}
+
+	return object, nil
+}
+
+// dynamicAttributes returns the attributes of value, which must be null, an
+// object or a map.
+func dynamicAttributes(ctx context.Context, value types.Dynamic) (map[string]attr.Value, error) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+
+	switch underlying := value.UnderlyingValue().(type) {
+	case types.Object:
+		return underlying.Attributes(), nil
+	case types.Map:
+		return underlying.Elements(), nil
+	default:
+		return nil, fmt.Errorf("expected an object, got %s", underlying.Type(ctx))
+	}
+}
+
+// newFunctionOptions reads the options object that some functions accept as
+// their last, variadic, argument. It fails if more than one object is passed.
+func newFunctionOptions(ctx context.Context, values []types.Dynamic, allowed ...string) (objectArgument, error) {
+	if len(values) > 1 {
+		return nil, fmt.Errorf("expected at most one options object, got %d", len(values))
+	}
+
+	if len(values) == 0 {
+		return objectArgument{}, nil
+	}
+
+	return newObjectArgument(ctx, values[0], allowed...)
+}
+
+// String returns the string attribute with the given name, or an empty string
+// when it is not set. Whole numbers are converted to their decimal form.
+func (o objectArgument) String(name string) (string, error) {
+	value, ok := o[name]
+	if !ok {
+		return "", nil
+	}
+
+	s, err := stringValue(value)
+	if err != nil {
+		return "", fmt.Errorf("attribute %q %w", name, err)
+	}
+
+	return s, nil
+}
+
+// Bool returns the bool attribute with the given name, or false when it is
+// not set.
+func (o objectArgument) Bool(name string) (bool, error) {
+	value, ok := o[name]
+	if !ok {
+		return false, nil
+	}
+
+	b, ok := value.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("attribute %q must be a bool", name)
+	}
+
+	return b.ValueBool(), nil
+}
+
+// Int returns the whole number attribute with the given name, or 0 when it
+// is not set.
+func (o objectArgument) Int(name string) (int, error) {
+	value, ok := o[name]
+	if !ok {
+		return 0, nil
+	}
+
+	n, ok := value.(types.Number)
+	if !ok || !n.ValueBigFloat().IsInt() {
+		return 0, fmt.Errorf("attribute %q must be a whole number", name)
+	}
+
+	i, _ := n.ValueBigFloat().Int64()
+
+	return int(i), nil
+}
+
+// StringList returns the list, tuple or set attribute with the given name as
+// a list of strings, or nil when it is not set.
+func (o objectArgument) StringList(name string) ([]string, error) {
+	value, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch v := value.(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("attribute %q must be a list of strings", name)
+	}
+
+	result := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, err := stringValue(element)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q element %w", name, err)
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// StringMap returns the object or map attribute with the given name as a map
+// of strings, or nil when it is not set.
+func (o objectArgument) StringMap(name string) (map[string]string, error) {
+	value, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var elements map[string]attr.Value
+	switch v := value.(type) {
+	case types.Object:
+		elements = v.Attributes()
+	case types.Map:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("attribute %q must be an object or a map", name)
+	}
+
+	result := make(map[string]string, len(elements))
+	for key, element := range elements {
+		s, err := stringValue(element)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q element %q %w", name, key, err)
+		}
+		result[key] = s
+	}
+
+	return result, nil
+}
+
+// StringListMap returns the attribute with the given name as a map from each
+// key to a list of strings. Each element of the attribute can be a string or a
+// list of strings.
+func (o objectArgument) StringListMap(name string) (map[string][]string, error) {
+	value, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var elements map[string]attr.Value
+	switch v := value.(type) {
+	case types.Object:
+		elements = v.Attributes()
+	case types.Map:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("attribute %q must be an object or a map", name)
+	}
+
+	result := make(map[string][]string, len(elements))
+	for key, element := range elements {
+		var items []attr.Value
+		switch v := element.(type) {
+		case types.List:
+			items = v.Elements()
+		case types.Tuple:
+			items = v.Elements()
+		case types.Set:
+			items = v.Elements()
+		default:
+			items = []attr.Value{element}
+		}
+
+		for _, item := range items {
+			s, err := stringValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q element %q %w", name, key, err)
+			}
+			result[key] = append(result[key], s)
+		}
+	}
+
+	return result, nil
+}
+
+func stringValue(value attr.Value) (string, error) {
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Number:
+		if v.ValueBigFloat().IsInt() {
+			return v.ValueBigFloat().Text('f', 0), nil
+		}
+		return v.ValueBigFloat().Text('g', -1), nil
+	case types.Bool:
+		return fmt.Sprint(v.ValueBool()), nil
+	default:
+		return "", errors.New("must be a string")
+	}
+}
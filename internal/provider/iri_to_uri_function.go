@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = IRIToURIFunction{}
+
+type IRIToURIFunction struct{}
+
+func NewIRIToURIFunction() function.Function {
+	return IRIToURIFunction{}
+}
+
+func (f IRIToURIFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iri_to_uri"
+}
+
+func (f IRIToURIFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             iriToURIMarkdownDescription,
+		MarkdownDescription: iriToURIMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "iri",
+				MarkdownDescription: iriAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f IRIToURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var iri string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &iri))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := netparse.IRIToURI(iri)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, uri))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = NormalizeURLFunction{}
+
+type NormalizeURLFunction struct{}
+
+func NewNormalizeURLFunction() function.Function {
+	return NormalizeURLFunction{}
+}
+
+func (f NormalizeURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_url"
+}
+
+func (f NormalizeURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             normalizeURLMarkdownDescription,
+		MarkdownDescription: normalizeURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: urlAttributeMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: normalizeURLOptionsMarkdownDescription,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f NormalizeURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url     string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := toNormalizeURLOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	normalized, err := netparse.NormalizeURL(url, opts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
+
+func toNormalizeURLOptions(ctx context.Context, options []types.Dynamic) (netparse.NormalizeURLOptions, error) {
+	var opts netparse.NormalizeURLOptions
+
+	object, err := newFunctionOptions(ctx, options, "sort_query", "remove_empty_fragment")
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.SortQuery, err = object.Bool("sort_query"); err != nil {
+		return opts, err
+	}
+
+	if opts.RemoveEmptyFragment, err = object.Bool("remove_empty_fragment"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
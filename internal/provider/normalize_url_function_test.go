@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNormalizeURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNormalizeURLFunctionConfig_basic("HTTPS://User@Example.COM:443/a/./b/../c/%7euser/%2f?b=2&a=1#"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://User@example.com/a/c/~user/%2F?b=2&a=1#"),
+					),
+				},
+			},
+			{
+				Config: testAccNormalizeURLFunctionConfig_options("HTTPS://User@Example.COM:443/a/./b/../c/%7euser/%2f?b=2&a=1&a=0#", true, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://User@example.com/a/c/~user/%2F?a=1&a=0&b=2"),
+					),
+				},
+			},
+			{
+				Config: testAccNormalizeURLFunctionConfig_basic("http://example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("http://example.com/"),
+					),
+				},
+			},
+			{
+				Config: testAccNormalizeURLFunctionConfig_basic("http://example.com:8080/"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("http://example.com:8080/"),
+					),
+				},
+			},
+			{
+				Config:      testAccNormalizeURLFunctionConfig_basic("://example.com"),
+				ExpectError: regexp.MustCompile(`missing protocol scheme`),
+			},
+		},
+	})
+}
+
+func TestNormalizeURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::normalize_url(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func TestNormalizeURLFunction_Unknown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "terraform_data" "test" {
+					input = "HTTPS://EXAMPLE.COM:443"
+				}
+
+				output "test" {
+					value = provider::netparse::normalize_url(terraform_data.test.output)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://example.com/"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccNormalizeURLFunctionConfig_basic(url string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::normalize_url(%[1]q)
+}
+`, url)
+}
+
+func testAccNormalizeURLFunctionConfig_options(url string, sortQuery bool, removeEmptyFragment bool) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::normalize_url(%[1]q, {
+		sort_query            = %[2]t
+		remove_empty_fragment = %[3]t
+	})
+}
+`, url, sortQuery, removeEmptyFragment)
+}
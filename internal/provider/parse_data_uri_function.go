@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"unicode/utf8"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseDataURIFunction{}
+
+type ParseDataURIFunction struct{}
+
+type parseDataURIFunctionReturnModel struct {
+	MediaType  string            `tfsdk:"media_type"`
+	Params     map[string]string `tfsdk:"params"`
+	Base64     bool              `tfsdk:"base64"`
+	Data       *string           `tfsdk:"data"`
+	DataBase64 string            `tfsdk:"data_base64"`
+}
+
+func NewParseDataURIFunction() function.Function {
+	return ParseDataURIFunction{}
+}
+
+// FromDataURIModel converts the data URI to the function result. The payload
+// is only returned as a string when it's valid UTF-8, because Terraform
+// strings can't hold arbitrary bytes.
+func FromDataURIModel(d *netparse.DataURIModel) parseDataURIFunctionReturnModel {
+	var data *string
+	if utf8.Valid(d.Data) {
+		s := string(d.Data)
+		data = &s
+	}
+
+	return parseDataURIFunctionReturnModel{
+		MediaType:  d.MediaType,
+		Params:     d.Params,
+		Base64:     d.Base64,
+		Data:       data,
+		DataBase64: base64.StdEncoding.EncodeToString(d.Data),
+	}
+}
+
+func (f ParseDataURIFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_data_uri"
+}
+
+func (f ParseDataURIFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseDataURIMarkdownDescription,
+		MarkdownDescription: parseDataURIMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: dataURIAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"media_type":  types.StringType,
+				"params":      types.MapType{ElemType: types.StringType},
+				"base64":      types.BoolType,
+				"data":        types.StringType,
+				"data_base64": types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseDataURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		uri string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	dataURIModel, err := netparse.ParseDataURI(uri)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromDataURIModel(dataURIModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseDataURIFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseDataURIFunctionConfig_basic("data:text/plain;charset=utf-8;base64,SGVsbG8sIFdvcmxkIQ=="),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"media_type": knownvalue.StringExact("text/plain"),
+							"params": knownvalue.MapExact(map[string]knownvalue.Check{
+								"charset": knownvalue.StringExact("utf-8"),
+							}),
+							"base64":      knownvalue.Bool(true),
+							"data":        knownvalue.StringExact("Hello, World!"),
+							"data_base64": knownvalue.StringExact("SGVsbG8sIFdvcmxkIQ=="),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseDataURIFunctionConfig_basic("data:,a%20b"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"media_type": knownvalue.StringExact("text/plain"),
+							"params": knownvalue.MapExact(map[string]knownvalue.Check{
+								"charset": knownvalue.StringExact("US-ASCII"),
+							}),
+							"base64":      knownvalue.Bool(false),
+							"data":        knownvalue.StringExact("a b"),
+							"data_base64": knownvalue.StringExact("YSBi"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseDataURIFunctionConfig_basic("data:image/png;base64,iVBORw0KGgo="),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"media_type":  knownvalue.StringExact("image/png"),
+							"params":      knownvalue.MapExact(map[string]knownvalue.Check{}),
+							"base64":      knownvalue.Bool(true),
+							"data":        knownvalue.Null(),
+							"data_base64": knownvalue.StringExact("iVBORw0KGgo="),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseDataURIFunctionConfig_basic("https://example.com"),
+				ExpectError: regexp.MustCompile(`doesn't have the data scheme`),
+			},
+		},
+	})
+}
+
+func TestParseDataURIFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_data_uri(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseDataURIFunctionConfig_basic(uri string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_data_uri(%[1]q)
+}
+`, uri)
+}
@@ -24,6 +24,15 @@ type parseDomainFunctionReturnModel struct {
 	TLD       string `tfsdk:"tld"`
 }
 
+// domainAttributeTypes are the attribute types of the parse_domain result.
+var domainAttributeTypes = map[string]attr.Type{
+	"domain":    types.StringType,
+	"manager":   types.StringType,
+	"sld":       types.StringType,
+	"subdomain": types.StringType,
+	"tld":       types.StringType,
+}
+
 func NewParseDomainFunction() function.Function {
 	return ParseDomainFunction{}
 }
@@ -53,13 +62,7 @@ func (f ParseDomainFunction) Definition(_ context.Context, _ function.Definition
 			},
 		},
 		Return: function.ObjectReturn{
-			AttributeTypes: map[string]attr.Type{
-				"domain":    types.StringType,
-				"manager":   types.StringType,
-				"sld":       types.StringType,
-				"subdomain": types.StringType,
-				"tld":       types.StringType,
-			},
+			AttributeTypes: domainAttributeTypes,
 		},
 	}
 }
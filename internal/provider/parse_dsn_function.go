@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseDSNFunction{}
+
+type ParseDSNFunction struct{}
+
+type parseDSNFunctionReturnModel struct {
+	Driver   string            `tfsdk:"driver"`
+	Hosts    []string          `tfsdk:"hosts"`
+	Ports    []int64           `tfsdk:"ports"`
+	Database string            `tfsdk:"database"`
+	User     string            `tfsdk:"user"`
+	Password string            `tfsdk:"password"`
+	Params   map[string]string `tfsdk:"params"`
+}
+
+func NewParseDSNFunction() function.Function {
+	return ParseDSNFunction{}
+}
+
+func FromDSNModel(d *netparse.DSNModel) parseDSNFunctionReturnModel {
+	hosts := make([]string, 0, len(d.Hosts))
+	hosts = append(hosts, d.Hosts...)
+
+	ports := make([]int64, 0, len(d.Ports))
+	for _, port := range d.Ports {
+		ports = append(ports, int64(port))
+	}
+
+	return parseDSNFunctionReturnModel{
+		Driver:   d.Driver,
+		Hosts:    hosts,
+		Ports:    ports,
+		Database: d.Database,
+		User:     d.User,
+		Password: d.Password,
+		Params:   d.Params,
+	}
+}
+
+func (f ParseDSNFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_dsn"
+}
+
+func (f ParseDSNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseDSNMarkdownDescription,
+		MarkdownDescription: parseDSNMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "dsn",
+				MarkdownDescription: dsnAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"driver":   types.StringType,
+				"hosts":    types.ListType{ElemType: types.StringType},
+				"ports":    types.ListType{ElemType: types.Int64Type},
+				"database": types.StringType,
+				"user":     types.StringType,
+				"password": types.StringType,
+				"params":   types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (f ParseDSNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		dsn string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &dsn))
+	if resp.Error != nil {
+		return
+	}
+
+	dsnModel, err := netparse.ParseDSN(dsn)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromDSNModel(dsnModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
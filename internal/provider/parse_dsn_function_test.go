@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseDSNFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseDSNFunctionConfig_basic("user:p@ss@tcp(db.example.com:3307)/orders?parseTime=true"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"driver":   knownvalue.StringExact("mysql"),
+							"hosts":    knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("db.example.com")}),
+							"ports":    knownvalue.ListExact([]knownvalue.Check{knownvalue.Int64Exact(3307)}),
+							"database": knownvalue.StringExact("orders"),
+							"user":     knownvalue.StringExact("user"),
+							"password": knownvalue.StringExact("p@ss"),
+							"params": knownvalue.MapExact(map[string]knownvalue.Check{
+								"parseTime": knownvalue.StringExact("true"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseDSNFunctionConfig_basic("jdbc:postgresql://db1.example.com,db2.example.com:5433/orders?user=app&ssl=true"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"driver": knownvalue.StringExact("postgres"),
+							"hosts": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("db1.example.com"),
+								knownvalue.StringExact("db2.example.com"),
+							}),
+							"ports": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.Int64Exact(5432),
+								knownvalue.Int64Exact(5433),
+							}),
+							"database": knownvalue.StringExact("orders"),
+							"user":     knownvalue.StringExact("app"),
+							"password": knownvalue.StringExact(""),
+							"params": knownvalue.MapExact(map[string]knownvalue.Check{
+								"ssl": knownvalue.StringExact("true"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseDSNFunctionConfig_basic("not a dsn"),
+				ExpectError: regexp.MustCompile(`unsupported DSN format`),
+			},
+		},
+	})
+}
+
+func TestParseDSNFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_dsn(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseDSNFunctionConfig_basic(dsn string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_dsn(%[1]q)
+}
+`, dsn)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseGitURLFunction{}
+
+type ParseGitURLFunction struct{}
+
+type parseGitURLFunctionReturnModel struct {
+	Transport string  `tfsdk:"transport"`
+	User      string  `tfsdk:"user"`
+	Host      string  `tfsdk:"host"`
+	Port      *int64  `tfsdk:"port"`
+	Owner     string  `tfsdk:"owner"`
+	Repo      string  `tfsdk:"repo"`
+	HTTPSURL  *string `tfsdk:"https_url"`
+	SSHURL    *string `tfsdk:"ssh_url"`
+}
+
+func NewParseGitURLFunction() function.Function {
+	return ParseGitURLFunction{}
+}
+
+// FromGitURLModel converts the git remote to the function result. A port that
+// is not set and the forms of a file remote are null.
+func FromGitURLModel(g *netparse.GitURLModel) parseGitURLFunctionReturnModel {
+	result := parseGitURLFunctionReturnModel{
+		Transport: g.Transport,
+		User:      g.User,
+		Host:      g.Host,
+		Owner:     g.Owner,
+		Repo:      g.Repo,
+	}
+
+	if g.Port != 0 {
+		port := int64(g.Port)
+		result.Port = &port
+	}
+
+	if g.HTTPSURL != "" {
+		result.HTTPSURL = &g.HTTPSURL
+	}
+
+	if g.SSHURL != "" {
+		result.SSHURL = &g.SSHURL
+	}
+
+	return result
+}
+
+func (f ParseGitURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_git_url"
+}
+
+func (f ParseGitURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseGitURLMarkdownDescription,
+		MarkdownDescription: parseGitURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: gitURLAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"transport": types.StringType,
+				"user":      types.StringType,
+				"host":      types.StringType,
+				"port":      types.Int64Type,
+				"owner":     types.StringType,
+				"repo":      types.StringType,
+				"https_url": types.StringType,
+				"ssh_url":   types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseGitURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url))
+	if resp.Error != nil {
+		return
+	}
+
+	gitURLModel, err := netparse.ParseGitURL(url)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromGitURLModel(gitURLModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseGitURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseGitURLFunctionConfig_basic("git@github.com:org/repo.git"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"transport": knownvalue.StringExact("ssh"),
+							"user":      knownvalue.StringExact("git"),
+							"host":      knownvalue.StringExact("github.com"),
+							"port":      knownvalue.Null(),
+							"owner":     knownvalue.StringExact("org"),
+							"repo":      knownvalue.StringExact("repo"),
+							"https_url": knownvalue.StringExact("https://github.com/org/repo.git"),
+							"ssh_url":   knownvalue.StringExact("git@github.com:org/repo.git"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseGitURLFunctionConfig_basic("ssh://deploy@git.example.com:2222/group/subgroup/app.git"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"transport": knownvalue.StringExact("ssh"),
+							"user":      knownvalue.StringExact("deploy"),
+							"host":      knownvalue.StringExact("git.example.com"),
+							"port":      knownvalue.Int64Exact(2222),
+							"owner":     knownvalue.StringExact("group/subgroup"),
+							"repo":      knownvalue.StringExact("app"),
+							"https_url": knownvalue.StringExact("https://git.example.com/group/subgroup/app.git"),
+							"ssh_url":   knownvalue.StringExact("ssh://deploy@git.example.com:2222/group/subgroup/app.git"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseGitURLFunctionConfig_basic("file:///srv/git/project.git"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"transport": knownvalue.StringExact("file"),
+							"user":      knownvalue.StringExact(""),
+							"host":      knownvalue.StringExact(""),
+							"port":      knownvalue.Null(),
+							"owner":     knownvalue.StringExact("srv/git"),
+							"repo":      knownvalue.StringExact("project"),
+							"https_url": knownvalue.Null(),
+							"ssh_url":   knownvalue.Null(),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseGitURLFunctionConfig_basic("ftp://example.com/repo.git"),
+				ExpectError: regexp.MustCompile(`unsupported git URL scheme "ftp"`),
+			},
+		},
+	})
+}
+
+func TestParseGitURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_git_url(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseGitURLFunctionConfig_basic(url string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_git_url(%[1]q)
+}
+`, url)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseImageReferenceFunction{}
+
+type ParseImageReferenceFunction struct{}
+
+type parseImageReferenceFunctionReturnModel struct {
+	Registry        string `tfsdk:"registry"`
+	Port            *int64 `tfsdk:"port"`
+	Repository      string `tfsdk:"repository"`
+	Tag             string `tfsdk:"tag"`
+	DigestAlgorithm string `tfsdk:"digest_algorithm"`
+	DigestHex       string `tfsdk:"digest_hex"`
+	Name            string `tfsdk:"name"`
+}
+
+func NewParseImageReferenceFunction() function.Function {
+	return ParseImageReferenceFunction{}
+}
+
+// FromImageReferenceModel converts the image reference to the function
+// result. A port that is not set is null.
+func FromImageReferenceModel(i *netparse.ImageReferenceModel) parseImageReferenceFunctionReturnModel {
+	result := parseImageReferenceFunctionReturnModel{
+		Registry:        i.Registry,
+		Repository:      i.Repository,
+		Tag:             i.Tag,
+		DigestAlgorithm: i.DigestAlgorithm,
+		DigestHex:       i.DigestHex,
+		Name:            i.Name,
+	}
+
+	if i.Port != 0 {
+		port := int64(i.Port)
+		result.Port = &port
+	}
+
+	return result
+}
+
+func (f ParseImageReferenceFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_image_reference"
+}
+
+func (f ParseImageReferenceFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseImageReferenceMarkdownDescription,
+		MarkdownDescription: parseImageReferenceMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "reference",
+				MarkdownDescription: imageReferenceAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"registry":         types.StringType,
+				"port":             types.Int64Type,
+				"repository":       types.StringType,
+				"tag":              types.StringType,
+				"digest_algorithm": types.StringType,
+				"digest_hex":       types.StringType,
+				"name":             types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseImageReferenceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		reference string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	imageReferenceModel, err := netparse.ParseImageReference(reference)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromImageReferenceModel(imageReferenceModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseImageReferenceFunction_Known(t *testing.T) {
+	digest := "3e2a3e5e0f1c0d2a5b6c7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f9012345"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseImageReferenceFunctionConfig_basic("registry.example.com:5000/team/app:1.2@sha256:" + digest),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"registry":         knownvalue.StringExact("registry.example.com"),
+							"port":             knownvalue.Int64Exact(5000),
+							"repository":       knownvalue.StringExact("team/app"),
+							"tag":              knownvalue.StringExact("1.2"),
+							"digest_algorithm": knownvalue.StringExact("sha256"),
+							"digest_hex":       knownvalue.StringExact(digest),
+							"name":             knownvalue.StringExact("registry.example.com:5000/team/app"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseImageReferenceFunctionConfig_basic("nginx"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"registry":         knownvalue.StringExact("docker.io"),
+							"port":             knownvalue.Null(),
+							"repository":       knownvalue.StringExact("library/nginx"),
+							"tag":              knownvalue.StringExact(""),
+							"digest_algorithm": knownvalue.StringExact(""),
+							"digest_hex":       knownvalue.StringExact(""),
+							"name":             knownvalue.StringExact("docker.io/library/nginx"),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseImageReferenceFunctionConfig_basic("Team/App"),
+				ExpectError: regexp.MustCompile(`repository name "Team/App" must be lowercase`),
+			},
+		},
+	})
+}
+
+func TestParseImageReferenceFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_image_reference(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseImageReferenceFunctionConfig_basic(reference string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_image_reference(%[1]q)
+}
+`, reference)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseMailtoFunction{}
+
+type ParseMailtoFunction struct{}
+
+type parseMailtoFunctionReturnModel struct {
+	To      []string          `tfsdk:"to"`
+	Cc      []string          `tfsdk:"cc"`
+	Bcc     []string          `tfsdk:"bcc"`
+	Subject string            `tfsdk:"subject"`
+	Body    string            `tfsdk:"body"`
+	Headers map[string]string `tfsdk:"headers"`
+}
+
+func NewParseMailtoFunction() function.Function {
+	return ParseMailtoFunction{}
+}
+
+func FromMailtoModel(m *netparse.MailtoModel) parseMailtoFunctionReturnModel {
+	return parseMailtoFunctionReturnModel{
+		To:      m.To,
+		Cc:      m.Cc,
+		Bcc:     m.Bcc,
+		Subject: m.Subject,
+		Body:    m.Body,
+		Headers: m.Headers,
+	}
+}
+
+func (f ParseMailtoFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_mailto"
+}
+
+func (f ParseMailtoFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseMailtoMarkdownDescription,
+		MarkdownDescription: parseMailtoMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: mailtoAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"to":      types.ListType{ElemType: types.StringType},
+				"cc":      types.ListType{ElemType: types.StringType},
+				"bcc":     types.ListType{ElemType: types.StringType},
+				"subject": types.StringType,
+				"body":    types.StringType,
+				"headers": types.MapType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (f ParseMailtoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		uri string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	mailtoModel, err := netparse.ParseMailto(uri)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromMailtoModel(mailtoModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseMailtoFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseMailtoFunctionConfig_basic("mailto:ops@example.com,oncall@example.com?cc=lead@example.com&bcc=audit@example.com&subject=Disk%20full&body=Usage%3A%2095%25&X-Priority=1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"to": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("ops@example.com"),
+								knownvalue.StringExact("oncall@example.com"),
+							}),
+							"cc": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("lead@example.com"),
+							}),
+							"bcc": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("audit@example.com"),
+							}),
+							"subject": knownvalue.StringExact("Disk full"),
+							"body":    knownvalue.StringExact("Usage: 95%"),
+							"headers": knownvalue.MapExact(map[string]knownvalue.Check{
+								"x-priority": knownvalue.StringExact("1"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseMailtoFunctionConfig_basic("mailto:?to=joe@example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"to": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("joe@example.com"),
+							}),
+							"cc":      knownvalue.ListExact([]knownvalue.Check{}),
+							"bcc":     knownvalue.ListExact([]knownvalue.Check{}),
+							"subject": knownvalue.StringExact(""),
+							"body":    knownvalue.StringExact(""),
+							"headers": knownvalue.MapExact(map[string]knownvalue.Check{}),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseMailtoFunctionConfig_basic("https://example.com"),
+				ExpectError: regexp.MustCompile(`doesn't have the mailto scheme`),
+			},
+		},
+	})
+}
+
+func TestParseMailtoFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_mailto(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseMailtoFunctionConfig_basic(uri string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_mailto(%[1]q)
+}
+`, uri)
+}
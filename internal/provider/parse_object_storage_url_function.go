@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseObjectStorageURLFunction{}
+
+type ParseObjectStorageURLFunction struct{}
+
+type parseObjectStorageURLFunctionReturnModel struct {
+	Provider string `tfsdk:"provider"`
+	Style    string `tfsdk:"style"`
+	Bucket   string `tfsdk:"bucket"`
+	Key      string `tfsdk:"key"`
+	Region   string `tfsdk:"region"`
+	Account  string `tfsdk:"account"`
+	Endpoint string `tfsdk:"endpoint"`
+}
+
+func NewParseObjectStorageURLFunction() function.Function {
+	return ParseObjectStorageURLFunction{}
+}
+
+func FromObjectStorageURLModel(o *netparse.ObjectStorageURLModel) parseObjectStorageURLFunctionReturnModel {
+	return parseObjectStorageURLFunctionReturnModel{
+		Provider: o.Provider,
+		Style:    o.Style,
+		Bucket:   o.Bucket,
+		Key:      o.Key,
+		Region:   o.Region,
+		Account:  o.Account,
+		Endpoint: o.Endpoint,
+	}
+}
+
+func (f ParseObjectStorageURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_object_storage_url"
+}
+
+func (f ParseObjectStorageURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseObjectStorageURLMarkdownDescription,
+		MarkdownDescription: parseObjectStorageURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: objectStorageURLAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"provider": types.StringType,
+				"style":    types.StringType,
+				"bucket":   types.StringType,
+				"key":      types.StringType,
+				"region":   types.StringType,
+				"account":  types.StringType,
+				"endpoint": types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseObjectStorageURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url))
+	if resp.Error != nil {
+		return
+	}
+
+	objectStorageURLModel, err := netparse.ParseObjectStorageURL(url)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromObjectStorageURLModel(objectStorageURLModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
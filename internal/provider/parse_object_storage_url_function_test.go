@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseObjectStorageURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseObjectStorageURLFunctionConfig_basic("https://my-bucket.s3.eu-west-1.amazonaws.com/path/to/object.csv"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"provider": knownvalue.StringExact("s3"),
+							"style":    knownvalue.StringExact("virtual-hosted"),
+							"bucket":   knownvalue.StringExact("my-bucket"),
+							"key":      knownvalue.StringExact("path/to/object.csv"),
+							"region":   knownvalue.StringExact("eu-west-1"),
+							"account":  knownvalue.StringExact(""),
+							"endpoint": knownvalue.StringExact("s3.eu-west-1.amazonaws.com"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseObjectStorageURLFunctionConfig_basic("gs://my-bucket/data/file.json"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"provider": knownvalue.StringExact("gcs"),
+							"style":    knownvalue.StringExact("uri"),
+							"bucket":   knownvalue.StringExact("my-bucket"),
+							"key":      knownvalue.StringExact("data/file.json"),
+							"region":   knownvalue.StringExact(""),
+							"account":  knownvalue.StringExact(""),
+							"endpoint": knownvalue.StringExact(""),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseObjectStorageURLFunctionConfig_basic("https://myaccount.blob.core.windows.net/mycontainer/dir/blob.txt"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"provider": knownvalue.StringExact("azure"),
+							"style":    knownvalue.StringExact("path"),
+							"bucket":   knownvalue.StringExact("mycontainer"),
+							"key":      knownvalue.StringExact("dir/blob.txt"),
+							"region":   knownvalue.StringExact(""),
+							"account":  knownvalue.StringExact("myaccount"),
+							"endpoint": knownvalue.StringExact("blob.core.windows.net"),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseObjectStorageURLFunctionConfig_basic("https://example.com/bucket/key"),
+				ExpectError: regexp.MustCompile(`is not an S3, Cloud Storage or Azure Blob Storage URL`),
+			},
+		},
+	})
+}
+
+func TestParseObjectStorageURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_object_storage_url(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseObjectStorageURLFunctionConfig_basic(url string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_object_storage_url(%[1]q)
+}
+`, url)
+}
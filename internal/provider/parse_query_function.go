@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseQueryFunction{}
+
+type ParseQueryFunction struct{}
+
+func NewParseQueryFunction() function.Function {
+	return ParseQueryFunction{}
+}
+
+func (f ParseQueryFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_query"
+}
+
+func (f ParseQueryFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseQueryMarkdownDescription,
+		MarkdownDescription: parseQueryMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "query",
+				MarkdownDescription: queryStringAttrMarkdownDescription,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ListType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseQueryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		query string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &query))
+	if resp.Error != nil {
+		return
+	}
+
+	result := netparse.ParseQuery(query)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseQueryFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseQueryFunctionConfig_basic("?tag=a%26b&tag=c+d&empty&redirect=https%3A%2F%2Fexample.com%2F%3Fx%3D1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"tag": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("a&b"),
+								knownvalue.StringExact("c d"),
+							}),
+							"empty": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact(""),
+							}),
+							"redirect": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("https://example.com/?x=1"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseQueryFunctionConfig_basic(""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.MapSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestParseQueryFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_query(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func TestParseQueryFunction_Unknown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "terraform_data" "test" {
+					input = "foo=bar"
+				}
+
+				output "test" {
+					value = provider::netparse::parse_query(terraform_data.test.output)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"foo": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.StringExact("bar"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccParseQueryFunctionConfig_basic(query string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_query(%[1]q)
+}
+`, query)
+}
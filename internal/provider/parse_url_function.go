@@ -17,19 +17,31 @@ var _ function.Function = ParseURLFunction{}
 type ParseURLFunction struct{}
 
 type parseURLFunctionReturnModel struct {
-	Authority   string `tfsdk:"authority"`
-	Protocol    string `tfsdk:"protocol"`
-	Scheme      string `tfsdk:"scheme"`
-	Credentials string `tfsdk:"credentials"`
-	Username    string `tfsdk:"username"`
-	Password    string `tfsdk:"password"`
-	Host        string `tfsdk:"host"`
-	Port        string `tfsdk:"port"`
-	Path        string `tfsdk:"path"`
-	Search      string `tfsdk:"search"`
-	Query       string `tfsdk:"query"`
-	Hash        string `tfsdk:"hash"`
-	Fragment    string `tfsdk:"fragment"`
+	Authority     string                          `tfsdk:"authority"`
+	Protocol      string                          `tfsdk:"protocol"`
+	Scheme        string                          `tfsdk:"scheme"`
+	Credentials   string                          `tfsdk:"credentials"`
+	Username      string                          `tfsdk:"username"`
+	Password      string                          `tfsdk:"password"`
+	Host          string                          `tfsdk:"host"`
+	HostType      string                          `tfsdk:"host_type"`
+	Zone          string                          `tfsdk:"zone"`
+	Domain        *parseDomainFunctionReturnModel `tfsdk:"domain"`
+	Port          string                          `tfsdk:"port"`
+	EffectivePort *int64                          `tfsdk:"effective_port"`
+	IsDefaultPort bool                            `tfsdk:"is_default_port"`
+	Path          string                          `tfsdk:"path"`
+	Search        string                          `tfsdk:"search"`
+	Query         string                          `tfsdk:"query"`
+	Hash          string                          `tfsdk:"hash"`
+	Fragment      string                          `tfsdk:"fragment"`
+	Origin        *string                         `tfsdk:"origin"`
+	Opaque        string                          `tfsdk:"opaque"`
+	RawPath       string                          `tfsdk:"raw_path"`
+	PathSegments  []string                        `tfsdk:"path_segments"`
+	ForceQuery    bool                            `tfsdk:"force_query"`
+	OmitHost      bool                            `tfsdk:"omit_host"`
+	RawFragment   string                          `tfsdk:"raw_fragment"`
 }
 
 func NewParseURLFunction() function.Function {
@@ -38,19 +50,31 @@ func NewParseURLFunction() function.Function {
 
 func FromURLModel(u *netparse.URLModel) parseURLFunctionReturnModel {
 	return parseURLFunctionReturnModel{
-		Authority:   u.Authority,
-		Protocol:    u.Protocol,
-		Scheme:      u.Scheme,
-		Credentials: u.Credentials,
-		Username:    u.Username,
-		Password:    u.Password,
-		Host:        u.Host,
-		Port:        u.Port,
-		Path:        u.Path,
-		Search:      u.Search,
-		Query:       u.Query,
-		Hash:        u.Hash,
-		Fragment:    u.Fragment,
+		Authority:     u.Authority,
+		Protocol:      u.Protocol,
+		Scheme:        u.Scheme,
+		Credentials:   u.Credentials,
+		Username:      u.Username,
+		Password:      u.Password,
+		Host:          u.Host,
+		HostType:      u.HostType,
+		Zone:          u.Zone,
+		Domain:        fromHostDomain(u.Domain),
+		Port:          u.Port,
+		EffectivePort: effectivePortValue(u).ValueInt64Pointer(),
+		IsDefaultPort: u.IsDefaultPort,
+		Path:          u.Path,
+		Search:        u.Search,
+		Query:         u.Query,
+		Hash:          u.Hash,
+		Fragment:      u.Fragment,
+		Origin:        originValue(u).ValueStringPointer(),
+		Opaque:        u.Opaque,
+		RawPath:       u.RawPath,
+		PathSegments:  u.PathSegments,
+		ForceQuery:    u.ForceQuery,
+		OmitHost:      u.OmitHost,
+		RawFragment:   u.RawFragment,
 	}
 }
 
@@ -68,21 +92,37 @@ func (f ParseURLFunction) Definition(_ context.Context, _ function.DefinitionReq
 				MarkdownDescription: urlAttributeMarkdownDescription,
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: parseURLOptionsMarkdownDescription,
+		},
 		Return: function.ObjectReturn{
 			AttributeTypes: map[string]attr.Type{
-				"authority":   types.StringType,
-				"scheme":      types.StringType,
-				"protocol":    types.StringType,
-				"credentials": types.StringType,
-				"username":    types.StringType,
-				"password":    types.StringType,
-				"host":        types.StringType,
-				"port":        types.StringType,
-				"path":        types.StringType,
-				"search":      types.StringType,
-				"query":       types.StringType,
-				"fragment":    types.StringType,
-				"hash":        types.StringType,
+				"authority":       types.StringType,
+				"scheme":          types.StringType,
+				"protocol":        types.StringType,
+				"credentials":     types.StringType,
+				"username":        types.StringType,
+				"password":        types.StringType,
+				"host":            types.StringType,
+				"host_type":       types.StringType,
+				"zone":            types.StringType,
+				"domain":          types.ObjectType{AttrTypes: domainAttributeTypes},
+				"port":            types.StringType,
+				"effective_port":  types.Int64Type,
+				"is_default_port": types.BoolType,
+				"path":            types.StringType,
+				"search":          types.StringType,
+				"query":           types.StringType,
+				"fragment":        types.StringType,
+				"hash":            types.StringType,
+				"origin":          types.StringType,
+				"opaque":          types.StringType,
+				"raw_path":        types.StringType,
+				"path_segments":   types.ListType{ElemType: types.StringType},
+				"force_query":     types.BoolType,
+				"omit_host":       types.BoolType,
+				"raw_fragment":    types.StringType,
 			},
 		},
 	}
@@ -90,15 +130,24 @@ func (f ParseURLFunction) Definition(_ context.Context, _ function.DefinitionReq
 
 func (f ParseURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var (
-		url string
+		url     string
+		options []types.Dynamic
 	)
 
-	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url))
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &options))
 	if resp.Error != nil {
 		return
 	}
 
-	urlModel, err := netparse.ParseURL(url)
+	opts, err := toParseURLOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	urlModel, err := netparse.ParseURLWithOptions(url, opts)
 	if err != nil {
 		resp.Error = function.ConcatFuncErrors(
 			function.NewFuncError(err.Error()),
@@ -110,3 +159,58 @@ func (f ParseURLFunction) Run(ctx context.Context, req function.RunRequest, resp
 
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
 }
+
+func toParseURLOptions(ctx context.Context, options []types.Dynamic) (netparse.ParseURLOptions, error) {
+	var opts netparse.ParseURLOptions
+
+	object, err := newFunctionOptions(ctx, options, "mode", "infer_scheme", "default_scheme")
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.Mode, err = object.String("mode"); err != nil {
+		return opts, err
+	}
+
+	if opts.InferScheme, err = object.Bool("infer_scheme"); err != nil {
+		return opts, err
+	}
+
+	if opts.DefaultScheme, err = object.String("default_scheme"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// effectivePortValue returns the effective port of the URL, or null when the
+// URL has no port and its scheme has no default port.
+func effectivePortValue(u *netparse.URLModel) types.Int64 {
+	if !u.HasEffectivePort {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(u.EffectivePort))
+}
+
+// originValue returns the origin of the URL, or null when the origin is
+// opaque.
+func originValue(u *netparse.URLModel) types.String {
+	if u.Origin == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(u.Origin)
+}
+
+// fromHostDomain returns the breakdown of the URL host, or nil when the host
+// is not a domain name with a registrable domain.
+func fromHostDomain(d *netparse.DomainModel) *parseDomainFunctionReturnModel {
+	if d == nil {
+		return nil
+	}
+
+	domain := FromDomainModel(d)
+
+	return &domain
+}
@@ -51,6 +51,11 @@ func TestParseURLFunction_Known(t *testing.T) {
 						tfjsonpath.New("host"),
 						knownvalue.StringExact("example.com"),
 					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("origin"),
+						knownvalue.StringExact("https://example.com:45"),
+					),
 					statecheck.ExpectKnownOutputValueAtPath(
 						"test",
 						tfjsonpath.New("password"),
@@ -97,6 +102,303 @@ func TestParseURLFunction_Known(t *testing.T) {
 	})
 }
 
+func TestParseURLFunction_Opaque(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURLFunctionConfig_basic("about:blank"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("opaque"),
+						knownvalue.StringExact("blank"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("path_segments"),
+						knownvalue.ListExact([]knownvalue.Check{}),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("https://example.com/files/a%2Fb.txt?"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("raw_path"),
+						knownvalue.StringExact("/files/a%2Fb.txt"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("path_segments"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("files"),
+							knownvalue.StringExact("a/b.txt"),
+						}),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("force_query"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestParseURLFunction_WHATWG(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURLFunctionConfig_mode("HTTPS://EXAMPLE.com:443\\a/./b/../c?q=1 2", "whatwg"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host"),
+						knownvalue.StringExact("example.com"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("path"),
+						knownvalue.StringExact("/a/c"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("port"),
+						knownvalue.StringExact(""),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("query"),
+						knownvalue.StringExact("q=1%202"),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_mode("http://0x7f.1/", "whatwg"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host"),
+						knownvalue.StringExact("127.0.0.1"),
+					),
+				},
+			},
+			{
+				Config:      testAccParseURLFunctionConfig_mode("https://example.com", "browser"),
+				ExpectError: regexp.MustCompile(`unsupported URL mode "browser"`),
+			},
+		},
+	})
+}
+
+func TestParseURLFunction_InferScheme(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURLFunctionConfig_inferScheme("db.internal:5432", "postgres"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("scheme"),
+						knownvalue.StringExact("postgres"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host"),
+						knownvalue.StringExact("db.internal"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("port"),
+						knownvalue.StringExact("5432"),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_inferScheme("example.com/path", ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("scheme"),
+						knownvalue.StringExact("https"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host"),
+						knownvalue.StringExact("example.com"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("path"),
+						knownvalue.StringExact("/path"),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_inferScheme("ftp://files.example.com", "postgres"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("scheme"),
+						knownvalue.StringExact("ftp"),
+					),
+				},
+			},
+			{
+				Config:      testAccParseURLFunctionConfig_inferScheme("example.com", "1http"),
+				ExpectError: regexp.MustCompile(`invalid default scheme "1http"`),
+			},
+		},
+	})
+}
+
+func TestParseURLFunction_EffectivePort(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURLFunctionConfig_basic("https://api.example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("port"),
+						knownvalue.StringExact(""),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("effective_port"),
+						knownvalue.Int64Exact(443),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("is_default_port"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("postgres://db.example.com:6432/app"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("effective_port"),
+						knownvalue.Int64Exact(6432),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("is_default_port"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("custom://example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("effective_port"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("is_default_port"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("custom://example.com:0"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("effective_port"),
+						knownvalue.Int64Exact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestParseURLFunction_Host(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURLFunctionConfig_basic("https://app.example.co.uk/"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host_type"),
+						knownvalue.StringExact("domain"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("domain"),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"domain":    knownvalue.StringExact("example.co.uk"),
+							"manager":   knownvalue.StringExact("ICANN"),
+							"sld":       knownvalue.StringExact("example"),
+							"subdomain": knownvalue.StringExact("app"),
+							"tld":       knownvalue.StringExact("co.uk"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("http://[fe80::1%25eth0]:8080/"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host_type"),
+						knownvalue.StringExact("ipv6"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("zone"),
+						knownvalue.StringExact("eth0"),
+					),
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("domain"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				Config: testAccParseURLFunctionConfig_basic("https://10.0.0.1/"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath(
+						"test",
+						tfjsonpath.New("host_type"),
+						knownvalue.StringExact("ipv4"),
+					),
+				},
+			},
+		},
+	})
+}
+
 func TestParseURLFunction_Null(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
@@ -152,3 +454,19 @@ output "test" {
 }
 `, url)
 }
+
+func testAccParseURLFunctionConfig_mode(url string, mode string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_url(%[1]q, { mode = %[2]q })
+}
+`, url, mode)
+}
+
+func testAccParseURLFunctionConfig_inferScheme(url string, defaultScheme string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_url(%[1]q, { infer_scheme = true, default_scheme = %[2]q })
+}
+`, url, defaultScheme)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseURNFunction{}
+
+type ParseURNFunction struct{}
+
+type parseURNFunctionReturnModel struct {
+	NID        string `tfsdk:"nid"`
+	NSS        string `tfsdk:"nss"`
+	RComponent string `tfsdk:"r_component"`
+	QComponent string `tfsdk:"q_component"`
+	FComponent string `tfsdk:"f_component"`
+	Normalized string `tfsdk:"normalized"`
+}
+
+func NewParseURNFunction() function.Function {
+	return ParseURNFunction{}
+}
+
+func FromURNModel(u *netparse.URNModel) parseURNFunctionReturnModel {
+	return parseURNFunctionReturnModel{
+		NID:        u.NID,
+		NSS:        u.NSS,
+		RComponent: u.RComponent,
+		QComponent: u.QComponent,
+		FComponent: u.FComponent,
+		Normalized: u.Normalized,
+	}
+}
+
+func (f ParseURNFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_urn"
+}
+
+func (f ParseURNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             parseURNMarkdownDescription,
+		MarkdownDescription: parseURNMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "urn",
+				MarkdownDescription: urnAttrMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"nid":         types.StringType,
+				"nss":         types.StringType,
+				"r_component": types.StringType,
+				"q_component": types.StringType,
+				"f_component": types.StringType,
+				"normalized":  types.StringType,
+			},
+		},
+	}
+}
+
+func (f ParseURNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		urn string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &urn))
+	if resp.Error != nil {
+		return
+	}
+
+	urnModel, err := netparse.ParseURN(urn)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	result := FromURNModel(urnModel)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseURNFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParseURNFunctionConfig_basic("URN:Acme:team/a%2fb?+res=1?=lang=en#top"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"nid":         knownvalue.StringExact("acme"),
+							"nss":         knownvalue.StringExact("team/a%2fb"),
+							"r_component": knownvalue.StringExact("res=1"),
+							"q_component": knownvalue.StringExact("lang=en"),
+							"f_component": knownvalue.StringExact("top"),
+							"normalized":  knownvalue.StringExact("urn:acme:team/a%2Fb"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccParseURNFunctionConfig_basic("urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"nid":         knownvalue.StringExact("uuid"),
+							"nss":         knownvalue.StringExact("6e8bc430-9c3a-11d9-9669-0800200c9a66"),
+							"r_component": knownvalue.StringExact(""),
+							"q_component": knownvalue.StringExact(""),
+							"f_component": knownvalue.StringExact(""),
+							"normalized":  knownvalue.StringExact("urn:uuid:6e8bc430-9c3a-11d9-9669-0800200c9a66"),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccParseURNFunctionConfig_basic("https://example.com"),
+				ExpectError: regexp.MustCompile(`doesn't have the urn scheme`),
+			},
+			{
+				Config:      testAccParseURNFunctionConfig_basic("urn:a:b"),
+				ExpectError: regexp.MustCompile(`invalid namespace identifier "a"`),
+			},
+		},
+	})
+}
+
+func TestParseURNFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::parse_urn(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccParseURNFunctionConfig_basic(urn string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::parse_urn(%[1]q)
+}
+`, urn)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = PathToFileURLFunction{}
+
+type PathToFileURLFunction struct{}
+
+func NewPathToFileURLFunction() function.Function {
+	return PathToFileURLFunction{}
+}
+
+func (f PathToFileURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "path_to_file_url"
+}
+
+func (f PathToFileURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             pathToFileURLMarkdownDescription,
+		MarkdownDescription: pathToFileURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "path",
+				MarkdownDescription: filePathAttrMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: fileURLOptionsMarkdownDescription,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f PathToFileURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		path    string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := toFileURLOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	fileURL, err := netparse.PathToFileURL(path, opts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fileURL))
+}
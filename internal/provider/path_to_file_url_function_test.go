@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPathToFileURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPathToFileURLFunctionConfig_basic("/var/lib/app data/state#1.json", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("file:///var/lib/app%20data/state%231.json"),
+					),
+				},
+			},
+			{
+				Config: testAccPathToFileURLFunctionConfig_basic(`C:\Users\me\My Documents`, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("file:///C:/Users/me/My%20Documents"),
+					),
+				},
+			},
+			{
+				Config: testAccPathToFileURLFunctionConfig_basic(`\\server\share\artifacts\app.zip`, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("file://server/share/artifacts/app.zip"),
+					),
+				},
+			},
+			{
+				Config:      testAccPathToFileURLFunctionConfig_basic("relative/path", false),
+				ExpectError: regexp.MustCompile(`path "relative/path" is not absolute`),
+			},
+		},
+	})
+}
+
+func TestPathToFileURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::path_to_file_url(null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccPathToFileURLFunctionConfig_basic(path string, windows bool) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::path_to_file_url(%[1]q, { windows = %[2]t })
+}
+`, path, windows)
+}
@@ -47,17 +47,44 @@ func (p *NetparseProvider) Resources(ctx context.Context) []func() resource.Reso
 func (p *NetparseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewURLDataSource,
+		NewQueryDataSource,
 		NewDomainDataSource,
 		NewCIDRDataSource,
+		NewDSNDataSource,
+		NewURLValidationDataSource,
 	}
 }
 
 func (p *NetparseProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewParseURLFunction,
+		NewParseQueryFunction,
+		NewBuildURLFunction,
+		NewNormalizeURLFunction,
+		NewResolveURLFunction,
+		NewFileURLToPathFunction,
+		NewPathToFileURLFunction,
+		NewURLEscapeFunction,
+		NewURLUnescapeFunction,
+		NewIRIToURIFunction,
+		NewURIToIRIFunction,
+		NewRedactURLFunction,
+		NewSameOriginFunction,
+		NewSameSiteFunction,
+		NewExpandURITemplateFunction,
+		NewValidateURLFunction,
+		NewURLPatternMatchFunction,
 		NewParseDomainFunction,
 		NewParseCIDRFunction,
 		NewContainsIPFunction,
+		NewParseDSNFunction,
+		NewParseDataURIFunction,
+		NewParseMailtoFunction,
+		NewParseURNFunction,
+		NewParseGitURLFunction,
+		NewParseObjectStorageURLFunction,
+		NewBuildObjectStorageURLFunction,
+		NewParseImageReferenceFunction,
 	}
 }
 
@@ -1,5 +1,86 @@
 package provider
 
-// TODO: For url_query.
-// https://registry.terraform.io/providers/northwood-labs/corefunc/latest/docs/data-sources/url_parse
-// https://url.spec.whatwg.org/#concept-url-query
+import (
+	"context"
+	"fmt"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var queryDataSourceTypeName = fmt.Sprintf("%s_url_query", providerTypeName)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &queryDataSource{}
+
+// queryDataSource defines the data source implementation.
+type queryDataSource struct{}
+
+// queryDataSourceModel describes the data source model.
+type queryDataSourceModel struct {
+	Query  types.String `tfsdk:"query"`
+	Params types.Map    `tfsdk:"params"`
+}
+
+func NewQueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+func (d *queryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_query"
+}
+
+func (d *queryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: queryMarkdownDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: queryStringAttrMarkdownDescription,
+				Required:            true,
+			},
+			"params": schema.MapAttribute{
+				MarkdownDescription: paramsAttrMarkdownDescription,
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data queryDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := data.update(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update data", err.Error())
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *queryDataSourceModel) update(ctx context.Context) error {
+	params := netparse.ParseQuery(d.Query.ValueString())
+
+	value, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, params)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert query params: %v", diags)
+	}
+
+	d.Params = value
+
+	return nil
+}
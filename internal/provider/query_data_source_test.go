@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryDataSource(t *testing.T) {
+	resourceFqn := "data.netparse_url_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceFqn,
+				Config:       testAccQueryDataSource("foo=bar&baz=qux&foo=a%26b+c"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFqn, "params.%", "2"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.foo.#", "2"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.foo.0", "bar"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.foo.1", "a&b c"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.baz.0", "qux"),
+				),
+			},
+			{
+				ResourceName: resourceFqn,
+				Config:       testAccQueryDataSource("?%F0%9F%92%A9=%E2%9C%93"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceFqn, "params.%", "1"),
+					resource.TestCheckResourceAttr(resourceFqn, "params.💩.0", "✓"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSource(query string) string {
+	return fmt.Sprintf(`
+data "netparse_url_query" "test" {
+  query = %[1]q
+}
+`, query)
+}
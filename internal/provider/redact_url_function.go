@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = RedactURLFunction{}
+
+type RedactURLFunction struct{}
+
+func NewRedactURLFunction() function.Function {
+	return RedactURLFunction{}
+}
+
+func (f RedactURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "redact_url"
+}
+
+func (f RedactURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             redactURLMarkdownDescription,
+		MarkdownDescription: redactURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: urlAttributeMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: redactURLOptionsMarkdownDescription,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f RedactURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url     string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := toRedactURLOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	redacted, err := netparse.RedactURL(url, opts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, redacted))
+}
+
+func toRedactURLOptions(ctx context.Context, options []types.Dynamic) (netparse.RedactURLOptions, error) {
+	var opts netparse.RedactURLOptions
+
+	object, err := newFunctionOptions(ctx, options, "mask", "redact_username", "query_params")
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.Mask, err = object.String("mask"); err != nil {
+		return opts, err
+	}
+
+	if opts.RedactUsername, err = object.Bool("redact_username"); err != nil {
+		return opts, err
+	}
+
+	if opts.QueryParams, err = object.StringList("query_params"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = ResolveURLFunction{}
+
+type ResolveURLFunction struct{}
+
+func NewResolveURLFunction() function.Function {
+	return ResolveURLFunction{}
+}
+
+func (f ResolveURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resolve_url"
+}
+
+func (f ResolveURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             resolveURLMarkdownDescription,
+		MarkdownDescription: resolveURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "base",
+				MarkdownDescription: baseURLAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "reference",
+				MarkdownDescription: referenceURLAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ResolveURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		base      string
+		reference string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &base, &reference))
+	if resp.Error != nil {
+		return
+	}
+
+	resolved, err := netparse.ResolveURL(base, reference)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resolved))
+}
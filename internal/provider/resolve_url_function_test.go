@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResolveURLFunction_Known(t *testing.T) {
+	// Examples from https://www.rfc-editor.org/rfc/rfc3986#section-5.4
+	references := map[string]string{
+		"g":       "http://a/b/c/g",
+		"./g":     "http://a/b/c/g",
+		"/g":      "http://a/g",
+		"//g":     "http://g",
+		"?y":      "http://a/b/c/d;p?y",
+		"#s":      "http://a/b/c/d;p?q#s",
+		"../g":    "http://a/b/g",
+		"../../g": "http://a/g",
+		"g;x?y#s": "http://a/b/c/g;x?y#s",
+		"g:h":     "g:h",
+	}
+
+	var steps []resource.TestStep
+	for reference, resolved := range references {
+		steps = append(steps, resource.TestStep{
+			Config: testAccResolveURLFunctionConfig_basic("http://a/b/c/d;p?q", reference),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownOutputValue(
+					"test",
+					knownvalue.StringExact(resolved),
+				),
+			},
+		})
+	}
+
+	steps = append(steps, resource.TestStep{
+		Config:      testAccResolveURLFunctionConfig_basic("/relative/base", "g"),
+		ExpectError: regexp.MustCompile(`is not absolute`),
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestResolveURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::resolve_url(null, "g")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::resolve_url("https://example.com", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func TestResolveURLFunction_Unknown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "terraform_data" "test" {
+					input = {
+						base      = "https://api.example.com/v1/"
+						reference = "health"
+					}
+				}
+
+				output "test" {
+					value = provider::netparse::resolve_url(terraform_data.test.output.base, terraform_data.test.output.reference)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("https://api.example.com/v1/health"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccResolveURLFunctionConfig_basic(base string, reference string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::resolve_url(%[1]q, %[2]q)
+}
+`, base, reference)
+}
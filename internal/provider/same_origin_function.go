@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = SameOriginFunction{}
+
+type SameOriginFunction struct{}
+
+func NewSameOriginFunction() function.Function {
+	return SameOriginFunction{}
+}
+
+func (f SameOriginFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "same_origin"
+}
+
+func (f SameOriginFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             sameOriginMarkdownDescription,
+		MarkdownDescription: sameOriginMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: firstURLAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: secondURLAttrMarkdownDescription,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f SameOriginFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		a string
+		b string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	sameOrigin, err := netparse.SameOrigin(a, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sameOrigin))
+}
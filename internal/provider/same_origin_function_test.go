@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSameOriginFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSameOriginFunctionConfig_basic("https://example.com/a", "https://EXAMPLE.com:443/b"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				Config: testAccSameOriginFunctionConfig_basic("https://example.com", "http://example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config: testAccSameOriginFunctionConfig_basic("https://example.com", "https://api.example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			{
+				Config:      testAccSameOriginFunctionConfig_basic("example.com", "https://example.com"),
+				ExpectError: regexp.MustCompile(`missing scheme`),
+			},
+		},
+	})
+}
+
+func TestSameOriginFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::same_origin(null, "https://example.com")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccSameOriginFunctionConfig_basic(a string, b string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::same_origin(%[1]q, %[2]q)
+}
+`, a, b)
+}
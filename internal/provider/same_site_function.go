@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = SameSiteFunction{}
+
+type SameSiteFunction struct{}
+
+func NewSameSiteFunction() function.Function {
+	return SameSiteFunction{}
+}
+
+func (f SameSiteFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "same_site"
+}
+
+func (f SameSiteFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             sameSiteMarkdownDescription,
+		MarkdownDescription: sameSiteMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: firstURLAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: secondURLAttrMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: sameSiteOptionsMarkdownDescription,
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f SameSiteFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		a       string
+		b       string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	schemeful, err := toSchemeful(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, err.Error()),
+		)
+		return
+	}
+
+	sameSite, err := netparse.SameSite(a, b, schemeful)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sameSite))
+}
+
+// toSchemeful reads the schemeful option, which defaults to true.
+func toSchemeful(ctx context.Context, options []types.Dynamic) (bool, error) {
+	object, err := newFunctionOptions(ctx, options, "schemeful")
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := object["schemeful"]; !ok {
+		return true, nil
+	}
+
+	return object.Bool("schemeful")
+}
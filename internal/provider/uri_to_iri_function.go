@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = URIToIRIFunction{}
+
+type URIToIRIFunction struct{}
+
+func NewURIToIRIFunction() function.Function {
+	return URIToIRIFunction{}
+}
+
+func (f URIToIRIFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uri_to_iri"
+}
+
+func (f URIToIRIFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             uriToIRIMarkdownDescription,
+		MarkdownDescription: uriToIRIMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: uriAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f URIToIRIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, netparse.URIToIRI(uri)))
+}
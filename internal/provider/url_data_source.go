@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,20 +25,50 @@ func NewURLDataSource() datasource.DataSource {
 type urlDataSource struct{}
 
 type urlDataSourceModel struct {
-	URL         types.String `tfsdk:"url"`
-	Authority   types.String `tfsdk:"authority"`
-	Protocol    types.String `tfsdk:"protocol"`
-	Scheme      types.String `tfsdk:"scheme"`
-	Credentials types.String `tfsdk:"credentials"`
-	Username    types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	Host        types.String `tfsdk:"host"`
-	Port        types.String `tfsdk:"port"`
-	Path        types.String `tfsdk:"path"`
-	Search      types.String `tfsdk:"search"`
-	Query       types.String `tfsdk:"query"`
-	Hash        types.String `tfsdk:"hash"`
-	Fragment    types.String `tfsdk:"fragment"`
+	URL           types.String `tfsdk:"url"`
+	Mode          types.String `tfsdk:"mode"`
+	InferScheme   types.Bool   `tfsdk:"infer_scheme"`
+	DefaultScheme types.String `tfsdk:"default_scheme"`
+	IncludeSpans  types.Bool   `tfsdk:"include_spans"`
+	RedactedURL   types.String `tfsdk:"redacted_url"`
+	Authority     types.String `tfsdk:"authority"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Scheme        types.String `tfsdk:"scheme"`
+	Credentials   types.String `tfsdk:"credentials"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	Host          types.String `tfsdk:"host"`
+	HostType      types.String `tfsdk:"host_type"`
+	Zone          types.String `tfsdk:"zone"`
+	Domain        types.Object `tfsdk:"domain"`
+	Port          types.String `tfsdk:"port"`
+	EffectivePort types.Int64  `tfsdk:"effective_port"`
+	IsDefaultPort types.Bool   `tfsdk:"is_default_port"`
+	Path          types.String `tfsdk:"path"`
+	Search        types.String `tfsdk:"search"`
+	Query         types.String `tfsdk:"query"`
+	Hash          types.String `tfsdk:"hash"`
+	Fragment      types.String `tfsdk:"fragment"`
+	Origin        types.String `tfsdk:"origin"`
+	Opaque        types.String `tfsdk:"opaque"`
+	RawPath       types.String `tfsdk:"raw_path"`
+	PathSegments  types.List   `tfsdk:"path_segments"`
+	ForceQuery    types.Bool   `tfsdk:"force_query"`
+	OmitHost      types.Bool   `tfsdk:"omit_host"`
+	RawFragment   types.String `tfsdk:"raw_fragment"`
+	Spans         types.Map    `tfsdk:"spans"`
+}
+
+type urlSpanModel struct {
+	Start int64 `tfsdk:"start"`
+	End   int64 `tfsdk:"end"`
+}
+
+// urlSpanAttributeTypes are the attribute types of the span of a URL
+// component.
+var urlSpanAttributeTypes = map[string]attr.Type{
+	"start": types.Int64Type,
+	"end":   types.Int64Type,
 }
 
 func NewURLDataSourceModel() *urlDataSourceModel {
@@ -50,13 +81,33 @@ func (u *urlDataSource) Metadata(ctx context.Context, req datasource.MetadataReq
 
 func (u *urlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: urlMarkdownDescription,
+		MarkdownDescription: urlDataSourceMarkdownDescription,
 
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
 				MarkdownDescription: urlAttributeMarkdownDescription,
 				Required:            true,
 			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: modeAttributeMarkdownDescription,
+				Optional:            true,
+			},
+			"infer_scheme": schema.BoolAttribute{
+				MarkdownDescription: inferSchemeAttributeMarkdownDescription,
+				Optional:            true,
+			},
+			"default_scheme": schema.StringAttribute{
+				MarkdownDescription: defaultSchemeAttributeMarkdownDescription,
+				Optional:            true,
+			},
+			"include_spans": schema.BoolAttribute{
+				MarkdownDescription: includeSpansAttributeMarkdownDescription,
+				Optional:            true,
+			},
+			"redacted_url": schema.StringAttribute{
+				MarkdownDescription: redactedURLAttributeMarkdownDescription,
+				Computed:            true,
+			},
 			"authority": schema.StringAttribute{
 				MarkdownDescription: authorityAttributeMarkdownDescription,
 				Computed:            true,
@@ -87,10 +138,52 @@ func (u *urlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: hostAttributeMarkdownDescription,
 				Computed:            true,
 			},
+			"host_type": schema.StringAttribute{
+				MarkdownDescription: hostTypeAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: zoneAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"domain": schema.SingleNestedAttribute{
+				MarkdownDescription: hostDomainAttributeMarkdownDescription,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						MarkdownDescription: domainAttrMarkdownDescription,
+						Computed:            true,
+					},
+					"manager": schema.StringAttribute{
+						MarkdownDescription: managerAttrMarkdownDescription,
+						Computed:            true,
+					},
+					"sld": schema.StringAttribute{
+						MarkdownDescription: sldAttrMarkdownDescription,
+						Computed:            true,
+					},
+					"subdomain": schema.StringAttribute{
+						MarkdownDescription: subdomainAttrMarkdownDescription,
+						Computed:            true,
+					},
+					"tld": schema.StringAttribute{
+						MarkdownDescription: tldAttrMarkdownDescription,
+						Computed:            true,
+					},
+				},
+			},
 			"port": schema.StringAttribute{
 				MarkdownDescription: portAttributeMarkdownDescription,
 				Computed:            true,
 			},
+			"effective_port": schema.Int64Attribute{
+				MarkdownDescription: effectivePortAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"is_default_port": schema.BoolAttribute{
+				MarkdownDescription: isDefaultPortAttributeMarkdownDescription,
+				Computed:            true,
+			},
 			"path": schema.StringAttribute{
 				MarkdownDescription: pathAttributeMarkdownDescription,
 				Computed:            true,
@@ -111,6 +204,51 @@ func (u *urlDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: hashAttributeMarkdownDescription,
 				Computed:            true,
 			},
+			"origin": schema.StringAttribute{
+				MarkdownDescription: originAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"opaque": schema.StringAttribute{
+				MarkdownDescription: opaqueAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"raw_path": schema.StringAttribute{
+				MarkdownDescription: rawPathAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"path_segments": schema.ListAttribute{
+				MarkdownDescription: pathSegmentsAttributeMarkdownDescription,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"force_query": schema.BoolAttribute{
+				MarkdownDescription: forceQueryAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"omit_host": schema.BoolAttribute{
+				MarkdownDescription: omitHostAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"raw_fragment": schema.StringAttribute{
+				MarkdownDescription: rawFragmentAttributeMarkdownDescription,
+				Computed:            true,
+			},
+			"spans": schema.MapNestedAttribute{
+				MarkdownDescription: spansAttributeMarkdownDescription,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.Int64Attribute{
+							MarkdownDescription: spanStartAttributeMarkdownDescription,
+							Computed:            true,
+						},
+						"end": schema.Int64Attribute{
+							MarkdownDescription: spanEndAttributeMarkdownDescription,
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -143,12 +281,17 @@ func (u *urlDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	resp.Diagnostics.Append(diags...)
 }
 
-func (u *urlDataSourceModel) update(_ context.Context) error {
-	url, err := netparse.ParseURL(u.URL.ValueString())
+func (u *urlDataSourceModel) update(ctx context.Context) error {
+	url, err := netparse.ParseURLWithOptions(u.URL.ValueString(), netparse.ParseURLOptions{
+		Mode:          u.Mode.ValueString(),
+		InferScheme:   u.InferScheme.ValueBool(),
+		DefaultScheme: u.DefaultScheme.ValueString(),
+	})
 	if err != nil {
 		return fmt.Errorf("failed to parse URL: %w", err)
 	}
 
+	u.RedactedURL = redactedURLValue(url)
 	u.Authority = types.StringValue(url.Authority)
 	u.Protocol = types.StringValue(url.Protocol)
 	u.Scheme = types.StringValue(url.Scheme)
@@ -156,12 +299,78 @@ func (u *urlDataSourceModel) update(_ context.Context) error {
 	u.Username = types.StringValue(url.Username)
 	u.Password = types.StringValue(url.Password)
 	u.Host = types.StringValue(url.Host)
+	u.HostType = types.StringValue(url.HostType)
+	u.Zone = types.StringValue(url.Zone)
+
+	domain, diags := types.ObjectValueFrom(ctx, domainAttributeTypes, fromHostDomain(url.Domain))
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert domain: %v", diags)
+	}
+	u.Domain = domain
+
 	u.Port = types.StringValue(url.Port)
+	u.EffectivePort = effectivePortValue(url)
+	u.IsDefaultPort = types.BoolValue(url.IsDefaultPort)
 	u.Path = types.StringValue(url.Path)
 	u.Search = types.StringValue(url.Search)
 	u.Query = types.StringValue(url.Query)
 	u.Hash = types.StringValue(url.Hash)
 	u.Fragment = types.StringValue(url.Fragment)
+	u.Origin = originValue(url)
+	u.Opaque = types.StringValue(url.Opaque)
+	u.RawPath = types.StringValue(url.RawPath)
+
+	pathSegments, diags := types.ListValueFrom(ctx, types.StringType, url.PathSegments)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert path segments: %v", diags)
+	}
+	u.PathSegments = pathSegments
+
+	u.ForceQuery = types.BoolValue(url.ForceQuery)
+	u.OmitHost = types.BoolValue(url.OmitHost)
+	u.RawFragment = types.StringValue(url.RawFragment)
+
+	spans, err := spansValue(ctx, url, u.IncludeSpans.ValueBool())
+	if err != nil {
+		return err
+	}
+	u.Spans = spans
 
 	return nil
 }
+
+// spansValue returns the spans of the URL components when include is true, or
+// null otherwise.
+func spansValue(ctx context.Context, url *netparse.URLModel, include bool) (types.Map, error) {
+	spanType := types.ObjectType{AttrTypes: urlSpanAttributeTypes}
+	if !include {
+		return types.MapNull(spanType), nil
+	}
+
+	if url.Spans == nil {
+		return types.MapNull(spanType), fmt.Errorf("spans are only supported in the %s mode", netparse.URLModeRFC3986)
+	}
+
+	spans := make(map[string]urlSpanModel, len(url.Spans))
+	for name, span := range url.Spans {
+		spans[name] = urlSpanModel{Start: int64(span.Start), End: int64(span.End)}
+	}
+
+	value, diags := types.MapValueFrom(ctx, spanType, spans)
+	if diags.HasError() {
+		return value, fmt.Errorf("failed to convert spans: %v", diags)
+	}
+
+	return value, nil
+}
+
+// redactedURLValue returns the parsed URL with the password redacted, or null
+// when it can't be redacted.
+func redactedURLValue(url *netparse.URLModel) types.String {
+	redactedURL, err := netparse.RedactURL(url.Href, netparse.RedactURLOptions{})
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(redactedURL)
+}
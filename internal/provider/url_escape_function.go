@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = URLEscapeFunction{}
+
+type URLEscapeFunction struct{}
+
+func NewURLEscapeFunction() function.Function {
+	return URLEscapeFunction{}
+}
+
+func (f URLEscapeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_escape"
+}
+
+func (f URLEscapeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             urlEscapeMarkdownDescription,
+		MarkdownDescription: urlEscapeMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: escapeValueAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "component",
+				MarkdownDescription: urlComponentAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f URLEscapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		value     string
+		component string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &component))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := netparse.EscapeURLComponent(value, component)
+	if err != nil {
+		if !slices.Contains(netparse.URLComponents, component) {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(1, err.Error()),
+			)
+			return
+		}
+
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestURLEscapeFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccURLEscapeFunctionConfig_basic("ci-bot@example.com", "userinfo"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("ci-bot%40example.com"),
+					),
+				},
+			},
+			{
+				Config: testAccURLEscapeFunctionConfig_basic("repos/a b", "path-segment"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("repos%2Fa%20b"),
+					),
+				},
+			},
+			{
+				Config: testAccURLEscapeFunctionConfig_basic("repos/a b", "path"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("repos/a%20b"),
+					),
+				},
+			},
+			{
+				Config: testAccURLEscapeFunctionConfig_basic("a=b&c", "query-value"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("a=b%26c"),
+					),
+				},
+			},
+			{
+				Config:      testAccURLEscapeFunctionConfig_basic("a", "query"),
+				ExpectError: regexp.MustCompile(`unsupported URL component "query"`),
+			},
+		},
+	})
+}
+
+func TestURLEscapeFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::url_escape(null, "path")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccURLEscapeFunctionConfig_basic(value string, component string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::url_escape(%[1]q, %[2]q)
+}
+`, value, component)
+}
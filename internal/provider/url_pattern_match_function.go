@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = URLPatternMatchFunction{}
+
+type URLPatternMatchFunction struct{}
+
+type urlPatternMatchFunctionReturnModel struct {
+	Matches bool                   `tfsdk:"matches"`
+	Groups  *urlPatternGroupsModel `tfsdk:"groups"`
+}
+
+type urlPatternGroupsModel struct {
+	Protocol map[string]*string `tfsdk:"protocol"`
+	Username map[string]*string `tfsdk:"username"`
+	Password map[string]*string `tfsdk:"password"`
+	Hostname map[string]*string `tfsdk:"hostname"`
+	Port     map[string]*string `tfsdk:"port"`
+	Pathname map[string]*string `tfsdk:"pathname"`
+	Search   map[string]*string `tfsdk:"search"`
+	Hash     map[string]*string `tfsdk:"hash"`
+}
+
+func NewURLPatternMatchFunction() function.Function {
+	return URLPatternMatchFunction{}
+}
+
+func FromURLPatternGroups(groups netparse.URLPatternGroups, matches bool) urlPatternMatchFunctionReturnModel {
+	if !matches {
+		return urlPatternMatchFunctionReturnModel{}
+	}
+
+	return urlPatternMatchFunctionReturnModel{
+		Matches: true,
+		Groups: &urlPatternGroupsModel{
+			Protocol: groups["protocol"],
+			Username: groups["username"],
+			Password: groups["password"],
+			Hostname: groups["hostname"],
+			Port:     groups["port"],
+			Pathname: groups["pathname"],
+			Search:   groups["search"],
+			Hash:     groups["hash"],
+		},
+	}
+}
+
+func (f URLPatternMatchFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_pattern_match"
+}
+
+func (f URLPatternMatchFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	groupsAttributeTypes := make(map[string]attr.Type, len(netparse.URLPatternComponents))
+	for _, component := range netparse.URLPatternComponents {
+		groupsAttributeTypes[component] = types.MapType{ElemType: types.StringType}
+	}
+
+	resp.Definition = function.Definition{
+		Summary:             urlPatternMatchMarkdownDescription,
+		MarkdownDescription: urlPatternMatchMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "pattern",
+				MarkdownDescription: urlPatternAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: urlAttributeMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:                "options",
+			MarkdownDescription: urlPatternOptionsMarkdownDescription,
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"matches": types.BoolType,
+				"groups":  types.ObjectType{AttrTypes: groupsAttributeTypes},
+			},
+		},
+	}
+}
+
+func (f URLPatternMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		pattern types.Dynamic
+		url     string
+		options []types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &url, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := toURLPatternOptions(ctx, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(2, err.Error()),
+		)
+		return
+	}
+
+	urlPattern, err := toURLPattern(ctx, pattern, opts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(0, err.Error()),
+		)
+		return
+	}
+
+	result := FromURLPatternGroups(urlPattern.Match(url))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func toURLPatternOptions(ctx context.Context, options []types.Dynamic) (netparse.URLPatternOptions, error) {
+	var opts netparse.URLPatternOptions
+
+	object, err := newFunctionOptions(ctx, options, "ignore_case")
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.IgnoreCase, err = object.Bool("ignore_case"); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// toURLPattern compiles the pattern, which is either a pattern string or an
+// object with the pattern of each URL component.
+func toURLPattern(ctx context.Context, value types.Dynamic, opts netparse.URLPatternOptions) (*netparse.URLPattern, error) {
+	if s, ok := value.UnderlyingValue().(types.String); ok {
+		return netparse.CompileURLPattern(s.ValueString(), opts)
+	}
+
+	object, err := newObjectArgument(ctx, value, netparse.URLPatternComponents...)
+	if err != nil {
+		return nil, err
+	}
+
+	init := make(map[string]string, len(object))
+	for name := range object {
+		if init[name], err = object.String(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return netparse.CompileURLPatternComponents(init, opts)
+}
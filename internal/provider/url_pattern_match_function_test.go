@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestURLPatternMatchFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccURLPatternMatchFunctionConfig_basic("https://*.example.com/books/:id?", "https://www.example.com/books?page=2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"matches": knownvalue.Bool(true),
+							"groups": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"protocol": knownvalue.MapExact(map[string]knownvalue.Check{}),
+								"username": knownvalue.MapExact(map[string]knownvalue.Check{
+									"0": knownvalue.StringExact(""),
+								}),
+								"password": knownvalue.MapExact(map[string]knownvalue.Check{
+									"0": knownvalue.StringExact(""),
+								}),
+								"hostname": knownvalue.MapExact(map[string]knownvalue.Check{
+									"0": knownvalue.StringExact("www"),
+								}),
+								"port": knownvalue.MapExact(map[string]knownvalue.Check{}),
+								"pathname": knownvalue.MapExact(map[string]knownvalue.Check{
+									"id": knownvalue.Null(),
+								}),
+								"search": knownvalue.MapExact(map[string]knownvalue.Check{
+									"0": knownvalue.StringExact("page=2"),
+								}),
+								"hash": knownvalue.MapExact(map[string]knownvalue.Check{
+									"0": knownvalue.StringExact(""),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccURLPatternMatchFunctionConfig_basic("https://*.example.com/books/:id?", "https://example.org/books/12"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"matches": knownvalue.Bool(false),
+							"groups":  knownvalue.Null(),
+						}),
+					),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::url_pattern_match({ pathname = "/Books/:id" }, "https://example.com/books/12", { ignore_case = true }).groups.pathname
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"id": knownvalue.StringExact("12"),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccURLPatternMatchFunctionConfig_basic("/books/:id", "https://example.com/books/12"),
+				ExpectError: regexp.MustCompile(`is not absolute`),
+			},
+		},
+	})
+}
+
+func TestURLPatternMatchFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::url_pattern_match("https://example.com/*", null)
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccURLPatternMatchFunctionConfig_basic(pattern string, url string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::url_pattern_match(%[1]q, %[2]q)
+}
+`, pattern, url)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = URLUnescapeFunction{}
+
+type URLUnescapeFunction struct{}
+
+func NewURLUnescapeFunction() function.Function {
+	return URLUnescapeFunction{}
+}
+
+func (f URLUnescapeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "url_unescape"
+}
+
+func (f URLUnescapeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             urlUnescapeMarkdownDescription,
+		MarkdownDescription: urlUnescapeMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: unescapeValueAttrMarkdownDescription,
+			},
+			function.StringParameter{
+				Name:                "component",
+				MarkdownDescription: urlComponentAttrMarkdownDescription,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f URLUnescapeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		value     string
+		component string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &component))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := netparse.UnescapeURLComponent(value, component)
+	if err != nil {
+		if !slices.Contains(netparse.URLComponents, component) {
+			resp.Error = function.ConcatFuncErrors(
+				function.NewArgumentFuncError(1, err.Error()),
+			)
+			return
+		}
+
+		resp.Error = function.ConcatFuncErrors(
+			function.NewFuncError(err.Error()),
+		)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
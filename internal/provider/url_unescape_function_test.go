@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestURLUnescapeFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccURLUnescapeFunctionConfig_basic("ci-bot%40example.com", "userinfo"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("ci-bot@example.com"),
+					),
+				},
+			},
+			{
+				Config: testAccURLUnescapeFunctionConfig_basic("a+b%2Bc", "query-value"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("a b+c"),
+					),
+				},
+			},
+			{
+				Config: testAccURLUnescapeFunctionConfig_basic("a+b%2Fc", "path-segment"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.StringExact("a+b/c"),
+					),
+				},
+			},
+			{
+				Config:      testAccURLUnescapeFunctionConfig_basic("100%", "path"),
+				ExpectError: regexp.MustCompile(`invalid percent-encoding "%" at offset 3`),
+			},
+			{
+				Config:      testAccURLUnescapeFunctionConfig_basic("a", "query"),
+				ExpectError: regexp.MustCompile(`unsupported URL component "query"`),
+			},
+		},
+	})
+}
+
+func TestURLUnescapeFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::url_unescape(null, "path")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccURLUnescapeFunctionConfig_basic(value string, component string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::url_unescape(%[1]q, %[2]q)
+}
+`, value, component)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var urlValidationDataSourceTypeName = fmt.Sprintf("%s_url_validation", providerTypeName)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &urlValidationDataSource{}
+
+// urlValidationDataSource defines the data source implementation.
+type urlValidationDataSource struct{}
+
+// urlValidationDataSourceModel describes the data source model.
+type urlValidationDataSourceModel struct {
+	URL                 types.String `tfsdk:"url"`
+	AllowedSchemes      types.List   `tfsdk:"allowed_schemes"`
+	RequireTLS          types.Bool   `tfsdk:"require_tls"`
+	ForbidCredentials   types.Bool   `tfsdk:"forbid_credentials"`
+	ForbidIPHosts       types.Bool   `tfsdk:"forbid_ip_hosts"`
+	AllowedHostSuffixes types.List   `tfsdk:"allowed_host_suffixes"`
+	MinPort             types.Int64  `tfsdk:"min_port"`
+	MaxPort             types.Int64  `tfsdk:"max_port"`
+	MaxLength           types.Int64  `tfsdk:"max_length"`
+	FailOnViolation     types.Bool   `tfsdk:"fail_on_violation"`
+	Valid               types.Bool   `tfsdk:"valid"`
+	Violations          types.List   `tfsdk:"violations"`
+}
+
+func NewURLValidationDataSource() datasource.DataSource {
+	return &urlValidationDataSource{}
+}
+
+func (d *urlValidationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_validation"
+}
+
+func (d *urlValidationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: urlValidationMarkdownDescription,
+
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: urlAttributeMarkdownDescription,
+				Required:            true,
+			},
+			"allowed_schemes": schema.ListAttribute{
+				MarkdownDescription: allowedSchemesAttrMarkdownDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"require_tls": schema.BoolAttribute{
+				MarkdownDescription: requireTLSAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"forbid_credentials": schema.BoolAttribute{
+				MarkdownDescription: forbidCredentialsAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"forbid_ip_hosts": schema.BoolAttribute{
+				MarkdownDescription: forbidIPHostsAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"allowed_host_suffixes": schema.ListAttribute{
+				MarkdownDescription: allowedHostSuffixesAttrMarkdownDescription,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"min_port": schema.Int64Attribute{
+				MarkdownDescription: minPortAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"max_port": schema.Int64Attribute{
+				MarkdownDescription: maxPortAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"max_length": schema.Int64Attribute{
+				MarkdownDescription: maxLengthAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"fail_on_violation": schema.BoolAttribute{
+				MarkdownDescription: failOnViolationAttrMarkdownDescription,
+				Optional:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: validAttrMarkdownDescription,
+				Computed:            true,
+			},
+			"violations": schema.ListNestedAttribute{
+				MarkdownDescription: violationsAttrMarkdownDescription,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule": schema.StringAttribute{
+							MarkdownDescription: violationRuleAttrMarkdownDescription,
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: violationMessageAttrMarkdownDescription,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *urlValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data urlValidationDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	violations, err := data.update(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update data", err.Error())
+	}
+
+	if data.FailOnViolation.IsNull() || data.FailOnViolation.ValueBool() {
+		for _, violation := range violations {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				fmt.Sprintf("URL violates the %s rule", violation.Rule),
+				violation.Message,
+			)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (d *urlValidationDataSourceModel) update(ctx context.Context) ([]netparse.URLViolation, error) {
+	policy := netparse.URLPolicy{
+		RequireTLS:        d.RequireTLS.ValueBool(),
+		ForbidCredentials: d.ForbidCredentials.ValueBool(),
+		ForbidIPHosts:     d.ForbidIPHosts.ValueBool(),
+		MinPort:           int(d.MinPort.ValueInt64()),
+		MaxPort:           int(d.MaxPort.ValueInt64()),
+		MaxLength:         int(d.MaxLength.ValueInt64()),
+	}
+
+	if diags := d.AllowedSchemes.ElementsAs(ctx, &policy.AllowedSchemes, false); diags.HasError() {
+		return nil, fmt.Errorf("failed to convert allowed schemes: %v", diags)
+	}
+
+	if diags := d.AllowedHostSuffixes.ElementsAs(ctx, &policy.AllowedHostSuffixes, false); diags.HasError() {
+		return nil, fmt.Errorf("failed to convert allowed host suffixes: %v", diags)
+	}
+
+	violations := netparse.ValidateURL(d.URL.ValueString(), policy)
+	result := FromURLViolations(violations)
+
+	value, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: urlViolationAttributeTypes}, result.Violations)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to convert violations: %v", diags)
+	}
+
+	d.Valid = types.BoolValue(result.Valid)
+	d.Violations = value
+
+	return violations, nil
+}
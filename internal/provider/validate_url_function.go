@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/gmeligio/terraform-provider-netparse/internal/netparse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ValidateURLFunction{}
+
+type ValidateURLFunction struct{}
+
+type validateURLFunctionReturnModel struct {
+	Valid      bool                `tfsdk:"valid"`
+	Violations []urlViolationModel `tfsdk:"violations"`
+}
+
+type urlViolationModel struct {
+	Rule    string `tfsdk:"rule"`
+	Message string `tfsdk:"message"`
+}
+
+// urlViolationAttributeTypes are the attribute types of a policy violation.
+var urlViolationAttributeTypes = map[string]attr.Type{
+	"rule":    types.StringType,
+	"message": types.StringType,
+}
+
+// urlPolicyAttributes are the attributes of a URL validation policy.
+var urlPolicyAttributes = []string{
+	"allowed_schemes",
+	"require_tls",
+	"forbid_credentials",
+	"forbid_ip_hosts",
+	"allowed_host_suffixes",
+	"min_port",
+	"max_port",
+	"max_length",
+}
+
+func NewValidateURLFunction() function.Function {
+	return ValidateURLFunction{}
+}
+
+func FromURLViolations(violations []netparse.URLViolation) validateURLFunctionReturnModel {
+	result := validateURLFunctionReturnModel{
+		Valid:      len(violations) == 0,
+		Violations: make([]urlViolationModel, 0, len(violations)),
+	}
+
+	for _, violation := range violations {
+		result.Violations = append(result.Violations, urlViolationModel{
+			Rule:    violation.Rule,
+			Message: violation.Message,
+		})
+	}
+
+	return result
+}
+
+func (f ValidateURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_url"
+}
+
+func (f ValidateURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             validateURLMarkdownDescription,
+		MarkdownDescription: validateURLMarkdownDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: urlAttributeMarkdownDescription,
+			},
+			function.DynamicParameter{
+				Name:                "policy",
+				MarkdownDescription: urlValidationPolicyMarkdownDescription,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"valid": types.BoolType,
+				"violations": types.ListType{
+					ElemType: types.ObjectType{AttrTypes: urlViolationAttributeTypes},
+				},
+			},
+		},
+	}
+}
+
+func (f ValidateURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		url    string
+		policy types.Dynamic
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	urlPolicy, err := toURLPolicy(ctx, policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(
+			function.NewArgumentFuncError(1, err.Error()),
+		)
+		return
+	}
+
+	result := FromURLViolations(netparse.ValidateURL(url, urlPolicy))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// toURLPolicy reads a URL validation policy from an object with the
+// attributes in urlPolicyAttributes.
+func toURLPolicy(ctx context.Context, value types.Dynamic) (netparse.URLPolicy, error) {
+	var policy netparse.URLPolicy
+
+	object, err := newObjectArgument(ctx, value, urlPolicyAttributes...)
+	if err != nil {
+		return policy, err
+	}
+
+	if policy.AllowedSchemes, err = object.StringList("allowed_schemes"); err != nil {
+		return policy, err
+	}
+
+	if policy.RequireTLS, err = object.Bool("require_tls"); err != nil {
+		return policy, err
+	}
+
+	if policy.ForbidCredentials, err = object.Bool("forbid_credentials"); err != nil {
+		return policy, err
+	}
+
+	if policy.ForbidIPHosts, err = object.Bool("forbid_ip_hosts"); err != nil {
+		return policy, err
+	}
+
+	if policy.AllowedHostSuffixes, err = object.StringList("allowed_host_suffixes"); err != nil {
+		return policy, err
+	}
+
+	if policy.MinPort, err = object.Int("min_port"); err != nil {
+		return policy, err
+	}
+
+	if policy.MaxPort, err = object.Int("max_port"); err != nil {
+		return policy, err
+	}
+
+	if policy.MaxLength, err = object.Int("max_length"); err != nil {
+		return policy, err
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestValidateURLFunction_Known(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccValidateURLFunctionConfig_basic(
+					"https://api.example.com/v1",
+					`{
+						require_tls           = true
+						forbid_credentials    = true
+						allowed_host_suffixes = ["example.com"]
+						max_port              = 1024
+					}`,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid":      knownvalue.Bool(true),
+							"violations": knownvalue.ListExact([]knownvalue.Check{}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccValidateURLFunctionConfig_basic(
+					"http://admin@10.0.0.1:8080",
+					`{
+						allowed_schemes = ["https"]
+						forbid_ip_hosts = true
+						max_port        = 1024
+					}`,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(false),
+							"violations": knownvalue.ListExact([]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"rule":    knownvalue.StringExact("allowed_schemes"),
+									"message": knownvalue.StringExact(`scheme "http" is not one of: https`),
+								}),
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"rule":    knownvalue.StringExact("forbid_ip_hosts"),
+									"message": knownvalue.StringExact(`host "10.0.0.1" is an IP address`),
+								}),
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"rule":    knownvalue.StringExact("port_range"),
+									"message": knownvalue.StringExact("port 8080 is greater than 1024"),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccValidateURLFunctionConfig_basic("http://[::1", "{}"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"valid": knownvalue.Bool(false),
+						}),
+					),
+				},
+			},
+			{
+				Config:      testAccValidateURLFunctionConfig_basic("https://example.com", `{ require_ssl = true }`),
+				ExpectError: regexp.MustCompile(`unsupported attribute "require_ssl"`),
+			},
+			{
+				Config:      testAccValidateURLFunctionConfig_basic("https://example.com", `{ max_port = 1.5 }`),
+				ExpectError: regexp.MustCompile(`attribute "max_port" must be a whole number`),
+			},
+		},
+	})
+}
+
+func TestValidateURLFunction_Null(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::netparse::validate_url(null, {})
+				}
+				`,
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func testAccValidateURLFunctionConfig_basic(url string, policy string) string {
+	return fmt.Sprintf(`
+output "test" {
+	value = provider::netparse::validate_url(%[1]q, %[2]s)
+}
+`, url, policy)
+}